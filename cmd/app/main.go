@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"flag"
 	"fmt"
 	"os"
@@ -13,17 +14,28 @@ import (
 
 	chgo "github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	_ "modernc.org/sqlite" // registers sqlite driver
 
 	"github.com/EpicStep/gdatum"
 	clickhouseAdapter "github.com/EpicStep/gdatum/internal/adapters/clickhouse"
+	postgresAdapter "github.com/EpicStep/gdatum/internal/adapters/postgres"
+	sqliteAdapter "github.com/EpicStep/gdatum/internal/adapters/sqlite"
 	"github.com/EpicStep/gdatum/internal/collector"
+	"github.com/EpicStep/gdatum/internal/collector/sources"
 	"github.com/EpicStep/gdatum/internal/config"
+	"github.com/EpicStep/gdatum/internal/domain"
 	"github.com/EpicStep/gdatum/internal/handlers/admin"
 	apiHandler "github.com/EpicStep/gdatum/internal/handlers/api"
+	"github.com/EpicStep/gdatum/internal/infrastructure/egress"
+	"github.com/EpicStep/gdatum/internal/infrastructure/lease"
 	clickhouseRepository "github.com/EpicStep/gdatum/internal/infrastructure/repository/clickhouse"
+	postgresRepository "github.com/EpicStep/gdatum/internal/infrastructure/repository/postgres"
+	sqliteRepository "github.com/EpicStep/gdatum/internal/infrastructure/repository/sqlite"
 	"github.com/EpicStep/gdatum/internal/infrastructure/server"
 	"github.com/EpicStep/gdatum/internal/infrastructure/worker"
 	"github.com/EpicStep/gdatum/internal/metrics"
@@ -67,7 +79,7 @@ func run(logger *zap.Logger) error {
 
 	if *runMigrations {
 		logger.Info("running migrations")
-		err = migrations.Run(ctx, cfg.DatabaseDSN, gdatum.MigrationsFS)
+		err = migrations.Run(ctx, cfg.DatabaseDriver, cfg.DatabaseDSN, gdatum.MigrationsFS)
 		if err != nil {
 			return fmt.Errorf("migrations.Run: %w", err)
 		}
@@ -75,15 +87,51 @@ func run(logger *zap.Logger) error {
 		return nil
 	}
 
-	db, err := openDB(ctx, cfg.DatabaseDSN)
+	repo, chDB, err := openDB(ctx, cfg)
 	if err != nil {
 		return fmt.Errorf("openDB: %w", err)
 	}
 
-	repo := clickhouseAdapter.New(clickhouseRepository.New(db))
+	egressClient, err := egress.New(egress.Opts{
+		Timeout:     cfg.EgressTimeout,
+		ProxyURL:    cfg.EgressProxyURL,
+		MaxBodySize: cfg.EgressMaxBodySize,
+		Metrics:     metrics.NewEgressMetrics(prometheus.DefaultRegisterer),
+		Logger:      logger,
+	})
+	if err != nil {
+		return fmt.Errorf("egress.New: %w", err)
+	}
 
-	statsHandler := collector.New(repo, metrics.NewCollectorMetrics(prometheus.DefaultRegisterer), logger)
-	statsCollectorWorker := worker.New("stats-collector", time.Hour, statsHandler.Handle, logger)
+	registry := collector.NewRegistry()
+	sources.Register(registry, egressClient)
+
+	statsHandler, err := collector.New(
+		repo,
+		registry,
+		lo.Map(cfg.Sources, func(source config.Source, _ int) collector.SourceConfig {
+			return collector.SourceConfig{
+				Name:       source.Name,
+				Kind:       source.Kind,
+				Interval:   source.Interval,
+				Schedule:   source.Schedule,
+				Jitter:     source.Jitter,
+				RunOnStart: source.RunOnStart,
+				MaxRuntime: source.MaxRuntime,
+				Options:    source.Options,
+			}
+		}),
+		metrics.NewCollectorMetrics(prometheus.DefaultRegisterer),
+		logger,
+	)
+	if err != nil {
+		return fmt.Errorf("collector.New: %w", err)
+	}
+
+	workerLease, err := newLease(ctx, cfg, chDB, logger)
+	if err != nil {
+		return fmt.Errorf("newLease: %w", err)
+	}
 
 	apiServer, err := api.NewServer(apiHandler.New(repo))
 	if err != nil {
@@ -100,9 +148,21 @@ func run(logger *zap.Logger) error {
 	eg.Go(func() error {
 		return adminServer.Run(eCtx)
 	})
-	eg.Go(func() error {
-		return statsCollectorWorker.Run(eCtx)
-	})
+
+	for _, job := range statsHandler.Jobs() {
+		statsCollectorWorker := worker.New("stats-collector-"+string(job.Multiplayer), job.Handle, worker.Opts{
+			Schedule:   job.Schedule,
+			Jitter:     job.Jitter,
+			RunOnStart: job.RunOnStart,
+			MaxRuntime: job.MaxRuntime,
+			Lease:      workerLease,
+			Logger:     logger,
+		})
+
+		eg.Go(func() error {
+			return statsCollectorWorker.Run(eCtx)
+		})
+	}
 
 	if err = eg.Wait(); err != nil {
 		return fmt.Errorf("eg.Wait: %w", err)
@@ -111,7 +171,64 @@ func run(logger *zap.Logger) error {
 	return nil
 }
 
-func openDB(ctx context.Context, dsn string) (driver.Conn, error) {
+func newLease(ctx context.Context, cfg *config.Config, db driver.Conn, logger *zap.Logger) (worker.Lease, error) {
+	switch cfg.LeaseDriver {
+	case config.LeaseDriverFile:
+		fileLease, err := lease.NewFile(cfg.LeaseFileDir)
+		if err != nil {
+			return nil, fmt.Errorf("lease.NewFile: %w", err)
+		}
+
+		return fileLease, nil
+	case config.LeaseDriverClickHouse:
+		if db == nil {
+			return nil, fmt.Errorf("lease driver %q requires database driver %q", config.LeaseDriverClickHouse, config.DatabaseDriverClickHouse)
+		}
+
+		clickhouseLease, err := lease.NewClickHouse(ctx, db, logger)
+		if err != nil {
+			return nil, fmt.Errorf("lease.NewClickHouse: %w", err)
+		}
+
+		return clickhouseLease, nil
+	default:
+		return nil, nil
+	}
+}
+
+// openDB opens the storage backend of the database driver.
+// ClickHouse connection is returned only for ClickHouse driver, because it is used by ClickHouse lease.
+func openDB(ctx context.Context, cfg *config.Config) (domain.Repository, driver.Conn, error) {
+	switch cfg.DatabaseDriver {
+	case config.DatabaseDriverPostgres, config.DatabaseDriverTimescaleDB:
+		db, err := openPostgres(ctx, cfg.DatabaseDSN)
+		if err != nil {
+			return nil, nil, fmt.Errorf("openPostgres: %w", err)
+		}
+
+		store := postgresRepository.New(db, postgresRepository.Opts{
+			TimescaleDB: cfg.DatabaseDriver == config.DatabaseDriverTimescaleDB,
+		})
+
+		return postgresAdapter.New(store), nil, nil
+	case config.DatabaseDriverSQLite:
+		db, err := openSQLite(ctx, cfg.DatabaseDSN)
+		if err != nil {
+			return nil, nil, fmt.Errorf("openSQLite: %w", err)
+		}
+
+		return sqliteAdapter.New(sqliteRepository.New(db)), nil, nil
+	default:
+		db, err := openClickHouse(ctx, cfg.DatabaseDSN)
+		if err != nil {
+			return nil, nil, fmt.Errorf("openClickHouse: %w", err)
+		}
+
+		return clickhouseAdapter.New(clickhouseRepository.New(db)), db, nil
+	}
+}
+
+func openClickHouse(ctx context.Context, dsn string) (driver.Conn, error) {
 	dbOpts, err := chgo.ParseDSN(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("clickhouse.ParseDSN: %w", err)
@@ -131,3 +248,46 @@ func openDB(ctx context.Context, dsn string) (driver.Conn, error) {
 
 	return db, nil
 }
+
+func openPostgres(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("pgxpool.ParseConfig: %w", err)
+	}
+
+	// buckets are computed in UTC, as in ClickHouse.
+	poolConfig.ConnConfig.RuntimeParams["timezone"] = "UTC"
+
+	db, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("pgxpool.NewWithConfig: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err = db.Ping(ctx); err != nil {
+		return nil, fmt.Errorf("db.Ping: %w", err)
+	}
+
+	return db, nil
+}
+
+func openSQLite(ctx context.Context, dsn string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql.Open: %w", err)
+	}
+
+	// SQLite allows a single writer, so connection is shared instead of waiting for lock.
+	db.SetMaxOpenConns(1)
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err = db.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("db.PingContext: %w", err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package a2s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/clients/a2s"
+)
+
+const (
+	defaultConcurrency  = 32
+	defaultQueryTimeout = 5 * time.Second
+	// defaultMaxFailedPercent tolerates a single dead target of a short list, but not an outage of all of them.
+	defaultMaxFailedPercent = 50
+)
+
+var errTooManyFailedQueries = errors.New("too many targets failed to respond to the query")
+
+type client interface {
+	Info(ctx context.Context, address string) (a2s.Info, error)
+}
+
+// Adapter collects servers of single multiplayer from configured targets by A2S_INFO query.
+type Adapter struct {
+	client           client
+	multiplayer      domain.Multiplayer
+	targets          []string
+	concurrency      int
+	queryTimeout     time.Duration
+	maxFailedPercent int
+}
+
+// NewOpts ...
+type NewOpts struct {
+	// Targets is a list of servers addresses in host:port format.
+	Targets []string
+	// Concurrency is a maximum number of servers queried at the same time.
+	Concurrency int
+	// QueryTimeout is a timeout of querying single server.
+	QueryTimeout time.Duration
+	// MaxFailedPercent is a percent of targets, that may fail to respond before collection fails,
+	// so network outage is not collected as all servers offline.
+	MaxFailedPercent int
+}
+
+func (o *NewOpts) setDefaults() {
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultConcurrency
+	}
+
+	if o.QueryTimeout <= 0 {
+		o.QueryTimeout = defaultQueryTimeout
+	}
+
+	if o.MaxFailedPercent <= 0 || o.MaxFailedPercent > 100 {
+		o.MaxFailedPercent = defaultMaxFailedPercent
+	}
+}
+
+// New ...
+func New(client client, multiplayer domain.Multiplayer, opts NewOpts) *Adapter {
+	opts.setDefaults()
+
+	return &Adapter{
+		client:           client,
+		multiplayer:      multiplayer,
+		targets:          opts.Targets,
+		concurrency:      opts.Concurrency,
+		queryTimeout:     opts.QueryTimeout,
+		maxFailedPercent: opts.MaxFailedPercent,
+	}
+}
+
+// Servers returns servers, that responded to the query. Bots are not counted as players.
+func (a *Adapter) Servers(ctx context.Context, collectedAt time.Time) ([]domain.Server, error) {
+	var (
+		result    = make([]domain.Server, 0, len(a.targets))
+		failed    int
+		lastErr   error
+		resultMux sync.Mutex
+	)
+
+	var eg errgroup.Group
+	eg.SetLimit(a.concurrency)
+
+	for _, target := range a.targets {
+		eg.Go(func() error {
+			qCtx, cancel := context.WithTimeout(ctx, a.queryTimeout)
+			defer cancel()
+
+			info, err := a.client.Info(qCtx, target)
+
+			resultMux.Lock()
+			defer resultMux.Unlock()
+
+			if err != nil {
+				// server is offline or doesn't respond to the query.
+				failed++
+				lastErr = err
+
+				return nil
+			}
+
+			result = append(result, domain.Server{
+				Multiplayer:  a.multiplayer,
+				Host:         target,
+				Name:         info.Name,
+				Gamemode:     info.Game,
+				PlayersCount: max(int32(info.Players)-int32(info.Bots), 0),
+				MaxPlayers:   int32(info.MaxPlayers),
+				Version:      info.Version,
+				Passworded:   lo.ToPtr(info.Passworded),
+				Tags:         keywordsToTags(info.Keywords),
+				CollectedAt:  collectedAt,
+			})
+
+			return nil
+		})
+	}
+
+	_ = eg.Wait() //nolint:errcheck
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if failed*100 > len(a.targets)*a.maxFailedPercent {
+		return nil, fmt.Errorf("%w: %d of %d: %w", errTooManyFailedQueries, failed, len(a.targets), lastErr)
+	}
+
+	return result, nil
+}
+
+// keywordsToTags splits comma separated keywords, that Source engine servers use as tags.
+func keywordsToTags(keywords string) []string {
+	var tags []string
+
+	for tag := range strings.SplitSeq(keywords, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package a2s
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/clients/a2s"
+)
+
+var errTimeout = errors.New("timeout")
+
+type fakeClient struct {
+	// responding are addresses, that respond to the query.
+	responding map[string]bool
+}
+
+func (c fakeClient) Info(_ context.Context, address string) (a2s.Info, error) {
+	if !c.responding[address] {
+		return a2s.Info{}, errTimeout
+	}
+
+	return a2s.Info{
+		Name:    "server " + address,
+		Players: 10,
+		Bots:    2,
+	}, nil
+}
+
+func TestAdapter_Servers(t *testing.T) {
+	t.Parallel()
+
+	targets := []string{"a", "b", "c", "d"}
+
+	tests := []struct {
+		name       string
+		responding map[string]bool
+		opts       NewOpts
+		wantHosts  []string
+		wantErr    bool
+	}{
+		{
+			name:       "AllResponded",
+			responding: map[string]bool{"a": true, "b": true, "c": true, "d": true},
+			wantHosts:  []string{"a", "b", "c", "d"},
+		},
+		{
+			name:       "SomeFailed",
+			responding: map[string]bool{"a": true, "b": true},
+			wantHosts:  []string{"a", "b"},
+		},
+		{
+			name:    "AllFailed",
+			wantErr: true,
+		},
+		{
+			name:       "FailedOverThreshold",
+			responding: map[string]bool{"a": true, "b": true, "c": true},
+			opts:       NewOpts{MaxFailedPercent: 20},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := tt.opts
+			opts.Targets = targets
+
+			adapter := New(fakeClient{responding: tt.responding}, "cs2", opts)
+
+			servers, err := adapter.Servers(t.Context(), time.Now())
+			if tt.wantErr {
+				require.ErrorIs(t, err, errTooManyFailedQueries)
+				assert.ErrorIs(t, err, errTimeout)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			hosts := make([]string, 0, len(servers))
+			for _, server := range servers {
+				assert.Equal(t, domain.Multiplayer("cs2"), server.Multiplayer)
+				assert.Equal(t, int32(8), server.PlayersCount)
+				hosts = append(hosts, server.Host)
+			}
+
+			assert.ElementsMatch(t, tt.wantHosts, hosts)
+		})
+	}
+}
@@ -19,13 +19,15 @@ type client interface {
 
 // Adapter ...
 type Adapter struct {
-	client client
+	client      client
+	multiplayer domain.Multiplayer
 }
 
-// New ...
-func New(client client) *Adapter {
+// New returns new Adapter, that collects servers as multiplayer.
+func New(client client, multiplayer domain.Multiplayer) *Adapter {
 	return &Adapter{
-		client: client,
+		client:      client,
+		multiplayer: multiplayer,
 	}
 }
 
@@ -38,12 +40,17 @@ func (a *Adapter) Servers(ctx context.Context, collectedAt time.Time) ([]domain.
 
 	return lo.Map(servers, func(server altv.Server, _ int) domain.Server {
 		return domain.Server{
-			Multiplayer:  domain.MultiplayerAltv,
+			Multiplayer:  a.multiplayer,
 			Host:         server.Address,
 			Name:         server.Name,
+			URL:          server.Website,
 			Gamemode:     server.Gamemode,
 			Language:     server.Language,
 			PlayersCount: server.PlayersCount,
+			MaxPlayers:   server.MaxPlayersCount,
+			Version:      string(server.Version),
+			Passworded:   lo.ToPtr(server.Passworded),
+			Tags:         server.Tags,
 			CollectedAt:  collectedAt,
 		}
 	}), nil
@@ -0,0 +1,73 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package cfx
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/clients/cfx"
+)
+
+const (
+	// emptyLocale is a locale that Cfx.re servers report when locale is not set.
+	emptyLocale = "root-AQ"
+)
+
+var colorCodesRegexp = regexp.MustCompile(`\^[0-9]`)
+
+type client interface {
+	Servers(ctx context.Context) (cfx.Servers, error)
+}
+
+// Adapter ...
+type Adapter struct {
+	client      client
+	multiplayer domain.Multiplayer
+	game        string
+}
+
+// New returns new Adapter, that collects servers of the game (cfx.GameFivem or cfx.GameRedm) as multiplayer.
+func New(client client, multiplayer domain.Multiplayer, game string) *Adapter {
+	return &Adapter{
+		client:      client,
+		multiplayer: multiplayer,
+		game:        game,
+	}
+}
+
+// Servers ...
+func (a *Adapter) Servers(ctx context.Context, collectedAt time.Time) ([]domain.Server, error) {
+	servers, err := a.client.Servers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(servers, func(server cfx.Server, _ int) (domain.Server, bool) {
+		if server.Game() != a.game {
+			return domain.Server{}, false
+		}
+
+		language := server.Locale()
+		if language == emptyLocale {
+			language = ""
+		}
+
+		return domain.Server{
+			Multiplayer:  a.multiplayer,
+			Host:         server.EndpointID,
+			Name:         colorCodesRegexp.ReplaceAllString(server.Hostname, ""),
+			Gamemode:     server.Gametype,
+			Language:     language,
+			PlayersCount: server.Clients,
+			MaxPlayers:   server.MaxClients,
+			Tags:         server.Tags(),
+			CollectedAt:  collectedAt,
+		}, true
+	}), nil
+}
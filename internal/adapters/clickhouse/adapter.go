@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/samber/lo"
 
@@ -16,11 +17,24 @@ import (
 )
 
 type clickhouseStore interface {
-	InsertServers(ctx context.Context, servers []clickhouse.Server) error
+	InsertServers(ctx context.Context, snapshotID string, servers []clickhouse.Server) error
 	ListMultiplayerSummaries(ctx context.Context, playersOrderAsc bool) ([]clickhouse.MultiplayerSummary, error)
+	ListMultiplayerStatistics(ctx context.Context, params domain.ListMultiplayerStatisticsParams) ([]clickhouse.MultiplayerStatisticPoint, error)
 	ListServerSummaries(ctx context.Context, params domain.ListServerSummariesParams) ([]clickhouse.ServerSummary, error)
+	ListServerSummariesPage(ctx context.Context, params domain.ListServerSummariesPageParams) ([]clickhouse.ServerSummary, error)
+	CountServers(ctx context.Context, multiplayer domain.Multiplayer, includeOffline bool) (uint64, error)
+	SearchServers(ctx context.Context, params domain.SearchServersParams) ([]clickhouse.ServerSummary, error)
+	ListServerFacets(ctx context.Context, params domain.SearchServersParams) ([]clickhouse.ServerFacetValue, error)
+	ListTrendingServers(ctx context.Context, params domain.ListTrendingServersParams) ([]clickhouse.TrendingServer, error)
 	GetServer(ctx context.Context, multiplayer domain.Multiplayer, host string) (clickhouse.Server, error)
 	ListServerStatistics(ctx context.Context, params domain.ListServerStatisticsParams) ([]clickhouse.ServerStatisticPoint, error)
+	ListServerMetadata(ctx context.Context, multiplayer domain.Multiplayer) ([]clickhouse.Server, error)
+	InsertServerChanges(ctx context.Context, snapshotID string, changes []clickhouse.ServerChange) error
+	ListServerChanges(ctx context.Context, params domain.ListServerChangesParams) ([]clickhouse.ServerChange, error)
+	InsertCollectionRun(ctx context.Context, run clickhouse.CollectionRun) error
+	ListCollectionRunBuckets(ctx context.Context, params domain.ListServerStatisticsParams) ([]clickhouse.CollectionRunBucket, error)
+	GetServerSeen(ctx context.Context, multiplayer domain.Multiplayer, host string) (clickhouse.ServerSeen, error)
+	ListServerRunPresence(ctx context.Context, multiplayer domain.Multiplayer, host string, from time.Time) ([]clickhouse.ServerRunPresence, error)
 }
 
 // Adapter ...
@@ -35,9 +49,9 @@ func New(store clickhouseStore) *Adapter {
 	}
 }
 
-// InsertServers ...
-func (a *Adapter) InsertServers(ctx context.Context, servers []domain.Server) error {
-	chServers := lo.Map(servers, func(srv domain.Server, _ int) clickhouse.Server {
+// InsertSnapshot ...
+func (a *Adapter) InsertSnapshot(ctx context.Context, snapshot domain.Snapshot) error {
+	chServers := lo.Map(snapshot.Servers, func(srv domain.Server, _ int) clickhouse.Server {
 		return clickhouse.Server{
 			Multiplayer:  string(srv.Multiplayer),
 			Host:         srv.Host,
@@ -46,11 +60,17 @@ func (a *Adapter) InsertServers(ctx context.Context, servers []domain.Server) er
 			Gamemode:     srv.Gamemode,
 			Language:     srv.Language,
 			PlayersCount: srv.PlayersCount,
+			MaxPlayers:   srv.MaxPlayers,
+			PeakPlayers:  srv.PeakPlayers,
+			Map:          srv.Map,
+			Version:      srv.Version,
+			Passworded:   srv.Passworded,
+			Tags:         srv.Tags,
 			CollectedAt:  srv.CollectedAt,
 		}
 	})
 
-	return a.store.InsertServers(ctx, chServers)
+	return a.store.InsertServers(ctx, snapshot.ID, chServers)
 }
 
 // ListMultiplayerSummaries ...
@@ -68,6 +88,30 @@ func (a *Adapter) ListMultiplayerSummaries(ctx context.Context, playersOrderAsc
 	}), nil
 }
 
+// ListMultiplayerStatistics ...
+func (a *Adapter) ListMultiplayerStatistics(ctx context.Context, params domain.ListMultiplayerStatisticsParams) ([]domain.MultiplayerStatisticPoint, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	statistics, err := a.store.ListMultiplayerStatistics(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statistics) == 0 {
+		return nil, domain.ErrMultiplayerNotFound
+	}
+
+	return lo.Map(statistics, func(statistic clickhouse.MultiplayerStatisticPoint, _ int) domain.MultiplayerStatisticPoint {
+		return domain.MultiplayerStatisticPoint{
+			PlayersCount: statistic.PlayersCount,
+			ServersCount: statistic.ServersCount,
+			CollectedAt:  statistic.CollectedAt,
+		}
+	}), nil
+}
+
 // GetServer ...
 func (a *Adapter) GetServer(ctx context.Context, multiplayer domain.Multiplayer, host string) (domain.Server, error) {
 	chServer, err := a.store.GetServer(ctx, multiplayer, host)
@@ -87,10 +131,42 @@ func (a *Adapter) GetServer(ctx context.Context, multiplayer domain.Multiplayer,
 		Gamemode:     chServer.Gamemode,
 		Language:     chServer.Language,
 		PlayersCount: chServer.PlayersCount,
+		MaxPlayers:   chServer.MaxPlayers,
+		PeakPlayers:  chServer.PeakPlayers,
+		Map:          chServer.Map,
+		Version:      chServer.Version,
+		Passworded:   chServer.Passworded,
+		Tags:         chServer.Tags,
 		CollectedAt:  chServer.CollectedAt,
 	}, nil
 }
 
+// GetServerUptime ...
+func (a *Adapter) GetServerUptime(ctx context.Context, multiplayer domain.Multiplayer, host string) (domain.ServerUptime, error) {
+	seen, err := a.store.GetServerSeen(ctx, multiplayer, host)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ServerUptime{}, domain.ErrServerNotFound
+		}
+
+		return domain.ServerUptime{}, err
+	}
+
+	now := time.Now()
+
+	runs, err := a.store.ListServerRunPresence(ctx, multiplayer, host, now.Add(-domain.ServerUptimeMaxWindow))
+	if err != nil {
+		return domain.ServerUptime{}, err
+	}
+
+	return domain.NewServerUptime(now, seen.FirstSeenAt, seen.LastSeenAt, lo.Map(runs, func(run clickhouse.ServerRunPresence, _ int) domain.ServerRunPresence {
+		return domain.ServerRunPresence{
+			CollectedAt: run.CollectedAt,
+			Present:     run.Present,
+		}
+	})), nil
+}
+
 // ListServerSummaries ...
 func (a *Adapter) ListServerSummaries(ctx context.Context, params domain.ListServerSummariesParams) ([]domain.ServerSummary, error) {
 	if err := params.Validate(); err != nil {
@@ -102,11 +178,119 @@ func (a *Adapter) ListServerSummaries(ctx context.Context, params domain.ListSer
 		return nil, err
 	}
 
-	return lo.Map(servers, func(server clickhouse.ServerSummary, _ int) domain.ServerSummary {
-		return domain.ServerSummary{
-			Host:         server.Host,
-			Name:         server.Name,
-			PlayersCount: server.PlayersCount,
+	return lo.Map(servers, bindServerSummary), nil
+}
+
+// ListServerSummariesPage ...
+func (a *Adapter) ListServerSummariesPage(ctx context.Context, params domain.ListServerSummariesPageParams) (domain.ServerSummariesPage, error) {
+	if err := params.Validate(); err != nil {
+		return domain.ServerSummariesPage{}, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	// one more server is requested to know, whether the next page exists.
+	pageParams := params
+	pageParams.Limit++
+
+	servers, err := a.store.ListServerSummariesPage(ctx, pageParams)
+	if err != nil {
+		return domain.ServerSummariesPage{}, err
+	}
+
+	total, err := a.store.CountServers(ctx, params.Multiplayer, params.IncludeOffline)
+	if err != nil {
+		return domain.ServerSummariesPage{}, err
+	}
+
+	page := domain.ServerSummariesPage{
+		Total: total,
+	}
+
+	if len(servers) > int(params.Limit) {
+		servers = servers[:params.Limit]
+
+		last := servers[len(servers)-1]
+		page.NextCursor = params.NextCursor(last.PlayersCount, last.Host)
+	}
+
+	page.Servers = lo.Map(servers, bindServerSummary)
+
+	return page, nil
+}
+
+// SearchServers ...
+func (a *Adapter) SearchServers(ctx context.Context, params domain.SearchServersParams) (domain.ServerSearchResult, error) {
+	if err := params.Validate(); err != nil {
+		return domain.ServerSearchResult{}, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	servers, err := a.store.SearchServers(ctx, params)
+	if err != nil {
+		return domain.ServerSearchResult{}, err
+	}
+
+	facets, err := a.store.ListServerFacets(ctx, params)
+	if err != nil {
+		return domain.ServerSearchResult{}, err
+	}
+
+	result := bindServerFacets(facets)
+	result.Servers = lo.Map(servers, bindServerSummary)
+
+	return result, nil
+}
+
+func bindServerSummary(server clickhouse.ServerSummary, _ int) domain.ServerSummary {
+	return domain.ServerSummary{
+		Host:         server.Host,
+		Name:         server.Name,
+		PlayersCount: server.PlayersCount,
+		Online:       server.Online,
+		LastSeenAt:   server.LastSeenAt,
+	}
+}
+
+// bindServerFacets returns search result with facets and total, that is counted by language facet,
+// because every server has exactly one language value.
+func bindServerFacets(facets []clickhouse.ServerFacetValue) domain.ServerSearchResult {
+	var result domain.ServerSearchResult
+
+	for _, facet := range facets {
+		value := domain.FacetValue{
+			Value: facet.Value,
+			Count: facet.Count,
+		}
+
+		switch facet.Facet {
+		case clickhouse.FacetLanguage:
+			result.Facets.Languages = append(result.Facets.Languages, value)
+			result.Total += facet.Count
+		case clickhouse.FacetGamemode:
+			result.Facets.Gamemodes = append(result.Facets.Gamemodes, value)
+		}
+	}
+
+	return result
+}
+
+// ListTrendingServers ...
+func (a *Adapter) ListTrendingServers(ctx context.Context, params domain.ListTrendingServersParams) ([]domain.TrendingServer, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	servers, err := a.store.ListTrendingServers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(servers, func(server clickhouse.TrendingServer, _ int) domain.TrendingServer {
+		return domain.TrendingServer{
+			Host:           server.Host,
+			Name:           server.Name,
+			PlayersBefore:  server.PlayersBefore,
+			PlayersAfter:   server.PlayersAfter,
+			PlayersChange:  server.PlayersChange,
+			RelativeChange: server.RelativeChange,
 		}
 	}), nil
 }
@@ -122,14 +306,142 @@ func (a *Adapter) ListServerStatistics(ctx context.Context, params domain.ListSe
 		return nil, err
 	}
 
-	if len(statistics) == 0 {
+	if !lo.ContainsBy(statistics, isPresentPoint) {
 		return nil, domain.ErrServerNotFound
 	}
 
+	var runs map[int64]bool
+	if !lo.EveryBy(statistics, isPresentPoint) {
+		buckets, err := a.store.ListCollectionRunBuckets(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		runs = lo.SliceToMap(buckets, func(bucket clickhouse.CollectionRunBucket) (int64, bool) {
+			return bucket.CollectedAt.Unix(), bucket.Succeeded
+		})
+	}
+
 	return lo.Map(statistics, func(statistic clickhouse.ServerStatisticPoint, _ int) domain.ServerStatisticPoint {
+		status := statisticStatus(statistic, runs)
+		if status != domain.ServerStatisticStatusOnline {
+			return domain.ServerStatisticPoint{
+				Status:      status,
+				CollectedAt: statistic.CollectedAt,
+			}
+		}
+
 		return domain.ServerStatisticPoint{
+			Status:       status,
 			PlayersCount: statistic.PlayersCount,
+			PlayersMin:   statistic.MinPlayersCount,
+			PlayersMax:   statistic.MaxPlayersCount,
+			PlayersP50:   statistic.P50PlayersCount,
+			PlayersP95:   statistic.P95PlayersCount,
+			Samples:      statistic.SamplesCount,
 			CollectedAt:  statistic.CollectedAt,
 		}
 	}), nil
 }
+
+func isPresentPoint(statistic clickhouse.ServerStatisticPoint) bool {
+	return statistic.Present != 0
+}
+
+// statisticStatus returns status of the point by collection runs of its bucket, that are keyed by unix time.
+func statisticStatus(statistic clickhouse.ServerStatisticPoint, runs map[int64]bool) domain.ServerStatisticStatus {
+	if isPresentPoint(statistic) {
+		return domain.ServerStatisticStatusOnline
+	}
+
+	succeeded, ok := runs[statistic.CollectedAt.Unix()]
+	switch {
+	case !ok:
+		return domain.ServerStatisticStatusUnknown
+	case succeeded:
+		return domain.ServerStatisticStatusOffline
+	default:
+		return domain.ServerStatisticStatusCollectionFailed
+	}
+}
+
+// ListServerMetadata ...
+func (a *Adapter) ListServerMetadata(ctx context.Context, multiplayer domain.Multiplayer) ([]domain.Server, error) {
+	servers, err := a.store.ListServerMetadata(ctx, multiplayer)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(servers, func(server clickhouse.Server, _ int) domain.Server {
+		return domain.Server{
+			Multiplayer: domain.Multiplayer(server.Multiplayer),
+			Host:        server.Host,
+			Name:        server.Name,
+			URL:         server.URL,
+			Gamemode:    server.Gamemode,
+			Language:    server.Language,
+		}
+	}), nil
+}
+
+// InsertServerChanges ...
+func (a *Adapter) InsertServerChanges(ctx context.Context, changes []domain.ServerChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	// changes are detected per snapshot, so all of them have the same multiplayer and time.
+	snapshotID := domain.SnapshotID(changes[0].Multiplayer, changes[0].ChangedAt)
+
+	return a.store.InsertServerChanges(ctx, snapshotID, lo.Map(changes, func(change domain.ServerChange, _ int) clickhouse.ServerChange {
+		return clickhouse.ServerChange{
+			Multiplayer: string(change.Multiplayer),
+			Host:        change.Host,
+			Field:       string(change.Field),
+			OldValue:    change.OldValue,
+			NewValue:    change.NewValue,
+			ChangedAt:   change.ChangedAt,
+		}
+	}))
+}
+
+// ListServerChanges ...
+func (a *Adapter) ListServerChanges(ctx context.Context, params domain.ListServerChangesParams) ([]domain.ServerChange, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	changes, err := a.store.ListServerChanges(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changes) == 0 && params.Offset == 0 {
+		// server without changes is not distinguishable from unknown one by history, so it is checked separately.
+		if _, err = a.GetServer(ctx, params.Multiplayer, params.Host); err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Map(changes, func(change clickhouse.ServerChange, _ int) domain.ServerChange {
+		return domain.ServerChange{
+			Multiplayer: domain.Multiplayer(change.Multiplayer),
+			Host:        change.Host,
+			Field:       domain.ServerField(change.Field),
+			OldValue:    change.OldValue,
+			NewValue:    change.NewValue,
+			ChangedAt:   change.ChangedAt,
+		}
+	}), nil
+}
+
+// InsertCollectionRun ...
+func (a *Adapter) InsertCollectionRun(ctx context.Context, run domain.CollectionRun) error {
+	return a.store.InsertCollectionRun(ctx, clickhouse.CollectionRun{
+		Multiplayer:  string(run.Multiplayer),
+		CollectedAt:  run.CollectedAt,
+		Status:       string(run.Status),
+		ServersCount: run.ServersCount,
+		Error:        run.Error,
+	})
+}
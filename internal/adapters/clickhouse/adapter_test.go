@@ -0,0 +1,158 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package clickhouse
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	chgo "github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum"
+	"github.com/EpicStep/gdatum/internal/config"
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/domain/repotest"
+	"github.com/EpicStep/gdatum/internal/infrastructure/repository/clickhouse"
+	"github.com/EpicStep/gdatum/internal/utils/migrations"
+)
+
+func TestAdapterConformance(t *testing.T) {
+	t.Parallel()
+
+	dsn := os.Getenv("TEST_CLICKHOUSE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_CLICKHOUSE_DSN is not set")
+	}
+
+	require.NoError(t, migrations.Run(t.Context(), config.DatabaseDriverClickHouse, dsn, gdatum.MigrationsFS))
+
+	dbOpts, err := chgo.ParseDSN(dsn)
+	require.NoError(t, err)
+
+	db, err := chgo.Open(dbOpts)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	repotest.Run(t, New(clickhouse.New(db)))
+}
+
+func TestStatisticStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		succeededAt = time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+		failedAt    = succeededAt.Add(time.Hour)
+		missingAt   = failedAt.Add(time.Hour)
+		runs        = map[int64]bool{
+			succeededAt.Unix(): true,
+			failedAt.Unix():    false,
+		}
+	)
+
+	tests := []struct {
+		name      string
+		statistic clickhouse.ServerStatisticPoint
+		expected  domain.ServerStatisticStatus
+	}{
+		{
+			name:      "Present",
+			statistic: clickhouse.ServerStatisticPoint{Present: 1, CollectedAt: missingAt},
+			expected:  domain.ServerStatisticStatusOnline,
+		},
+		{
+			name:      "Offline",
+			statistic: clickhouse.ServerStatisticPoint{CollectedAt: succeededAt},
+			expected:  domain.ServerStatisticStatusOffline,
+		},
+		{
+			name:      "CollectionFailed",
+			statistic: clickhouse.ServerStatisticPoint{CollectedAt: failedAt},
+			expected:  domain.ServerStatisticStatusCollectionFailed,
+		},
+		{
+			name:      "Unknown",
+			statistic: clickhouse.ServerStatisticPoint{CollectedAt: missingAt},
+			expected:  domain.ServerStatisticStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, statisticStatus(tt.statistic, runs))
+		})
+	}
+}
+
+func TestBindServerFacets(t *testing.T) {
+	t.Parallel()
+
+	result := bindServerFacets([]clickhouse.ServerFacetValue{
+		{Facet: clickhouse.FacetLanguage, Value: "en", Count: 3},
+		{Facet: clickhouse.FacetGamemode, Value: "rp", Count: 4},
+		{Facet: clickhouse.FacetLanguage, Value: "de", Count: 1},
+	})
+
+	assert.Equal(t, domain.ServerSearchResult{
+		Total: 4,
+		Facets: domain.ServerFacets{
+			Languages: []domain.FacetValue{{Value: "en", Count: 3}, {Value: "de", Count: 1}},
+			Gamemodes: []domain.FacetValue{{Value: "rp", Count: 4}},
+		},
+	}, result)
+}
+
+type fakePageStore struct {
+	clickhouseStore
+
+	servers []clickhouse.ServerSummary
+}
+
+func (s *fakePageStore) ListServerSummariesPage(_ context.Context, params domain.ListServerSummariesPageParams) ([]clickhouse.ServerSummary, error) {
+	return s.servers[:min(int(params.Limit), len(s.servers))], nil
+}
+
+func (s *fakePageStore) CountServers(context.Context, domain.Multiplayer, bool) (uint64, error) {
+	return uint64(len(s.servers)), nil
+}
+
+func TestAdapter_ListServerSummariesPage(t *testing.T) {
+	t.Parallel()
+
+	adapter := New(&fakePageStore{
+		servers: []clickhouse.ServerSummary{
+			{Host: "a", PlayersCount: 10},
+			{Host: "b", PlayersCount: 5},
+			{Host: "c", PlayersCount: 5},
+		},
+	})
+
+	t.Run("HasNext", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := adapter.ListServerSummariesPage(t.Context(), domain.ListServerSummariesPageParams{Limit: 2})
+		require.NoError(t, err)
+
+		assert.Len(t, page.Servers, 2)
+		assert.Equal(t, uint64(3), page.Total)
+		assert.Equal(t, &domain.ServerSummaryCursor{PlayersCount: 5, Host: "b"}, page.NextCursor)
+	})
+
+	t.Run("Last", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := adapter.ListServerSummariesPage(t.Context(), domain.ListServerSummariesPageParams{Limit: 3})
+		require.NoError(t, err)
+
+		assert.Len(t, page.Servers, 3)
+		assert.Nil(t, page.NextCursor)
+	})
+}
@@ -0,0 +1,779 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+// Package memory is an in-memory domain.Repository for tests and local development.
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+)
+
+// latestSnapshotMaxAge is a maximum age of the snapshot, that is treated as the latest one.
+// Multiplayers that were not collected for longer are treated as offline.
+const latestSnapshotMaxAge = 24 * time.Hour
+
+type serverKey struct {
+	multiplayer domain.Multiplayer
+	host        string
+}
+
+type sampleKey struct {
+	serverKey
+	collectedAt int64
+}
+
+type changeKey struct {
+	serverKey
+	field     domain.ServerField
+	changedAt int64
+}
+
+type runKey struct {
+	multiplayer domain.Multiplayer
+	collectedAt int64
+	status      domain.CollectionRunStatus
+}
+
+// Repository is a thread-safe in-memory domain.Repository, that has the same semantics as database backends.
+// Times are stored with seconds precision, as in databases.
+type Repository struct {
+	mu sync.RWMutex
+
+	// samples are players counts of servers per snapshot.
+	samples map[sampleKey]int32
+	// servers are the last known servers with metadata.
+	servers map[serverKey]domain.Server
+	changes map[changeKey]domain.ServerChange
+	runs    map[runKey]domain.CollectionRun
+}
+
+// New returns new empty Repository.
+func New() *Repository {
+	return &Repository{
+		samples: make(map[sampleKey]int32),
+		servers: make(map[serverKey]domain.Server),
+		changes: make(map[changeKey]domain.ServerChange),
+		runs:    make(map[runKey]domain.CollectionRun),
+	}
+}
+
+// InsertSnapshot inserts servers of the snapshot, repeated insert of the same snapshot is ignored.
+func (r *Repository) InsertSnapshot(_ context.Context, snapshot domain.Snapshot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, server := range snapshot.Servers {
+		server.CollectedAt = truncate(server.CollectedAt)
+		server.Tags = slices.Clone(server.Tags)
+
+		key := serverKey{multiplayer: server.Multiplayer, host: server.Host}
+
+		sample := sampleKey{serverKey: key, collectedAt: server.CollectedAt.Unix()}
+		if _, ok := r.samples[sample]; !ok {
+			r.samples[sample] = server.PlayersCount
+		}
+
+		// info of older snapshot, e.g. inserted by retry, does not override newer one.
+		if known, ok := r.servers[key]; !ok || !server.CollectedAt.Before(known.CollectedAt) {
+			r.servers[key] = server
+		}
+	}
+
+	return nil
+}
+
+// ListMultiplayerSummaries ...
+func (r *Repository) ListMultiplayerSummaries(_ context.Context, playersOrderAsc bool) ([]domain.MultiplayerSummary, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	players := make(map[domain.Multiplayer]int64)
+
+	for multiplayer, snapshotAt := range r.latestSnapshots() {
+		for key, playersCount := range r.samples {
+			if key.multiplayer == multiplayer && key.collectedAt == snapshotAt {
+				players[multiplayer] += int64(playersCount)
+			}
+		}
+	}
+
+	summaries := make([]domain.MultiplayerSummary, 0, len(players))
+	for multiplayer, playersCount := range players {
+		summaries = append(summaries, domain.MultiplayerSummary{
+			Name:         multiplayer,
+			PlayersCount: playersCount,
+		})
+	}
+
+	slices.SortFunc(summaries, func(a, b domain.MultiplayerSummary) int {
+		return cmp.Or(comparePlayers(a.PlayersCount, b.PlayersCount, playersOrderAsc), cmp.Compare(a.Name, b.Name))
+	})
+
+	return summaries, nil
+}
+
+// ListMultiplayerStatistics ...
+func (r *Repository) ListMultiplayerStatistics(_ context.Context, params domain.ListMultiplayerStatisticsParams) ([]domain.MultiplayerStatisticPoint, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	type snapshot struct {
+		playersCount int64
+		serversCount int64
+	}
+
+	snapshots := make(map[int64]snapshot)
+
+	for key, playersCount := range r.samples {
+		if key.multiplayer != params.Multiplayer || !inTimeRange(key.collectedAt, params.TimeRange) {
+			continue
+		}
+
+		s := snapshots[key.collectedAt]
+		s.playersCount += int64(playersCount)
+		s.serversCount++
+		snapshots[key.collectedAt] = s
+	}
+
+	if len(snapshots) == 0 {
+		return nil, domain.ErrMultiplayerNotFound
+	}
+
+	buckets := lo.GroupBy(slices.Collect(maps.Keys(snapshots)), func(collectedAt int64) time.Time {
+		return params.Precision.BucketStart(time.Unix(collectedAt, 0))
+	})
+
+	points := make([]domain.MultiplayerStatisticPoint, 0, len(buckets))
+	for bucket, collectedAts := range buckets {
+		var playersCount, serversCount int64
+		for _, collectedAt := range collectedAts {
+			playersCount += snapshots[collectedAt].playersCount
+			serversCount += snapshots[collectedAt].serversCount
+		}
+
+		points = append(points, domain.MultiplayerStatisticPoint{
+			PlayersCount: playersCount / int64(len(collectedAts)),
+			ServersCount: serversCount / int64(len(collectedAts)),
+			CollectedAt:  bucket,
+		})
+	}
+
+	slices.SortFunc(points, func(a, b domain.MultiplayerStatisticPoint) int {
+		return b.CollectedAt.Compare(a.CollectedAt)
+	})
+
+	return points, nil
+}
+
+// ListServerSummaries ...
+func (r *Repository) ListServerSummaries(_ context.Context, params domain.ListServerSummariesParams) ([]domain.ServerSummary, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	servers := r.currentServers(params.Multiplayer, params.IncludeOffline)
+
+	slices.SortFunc(servers, func(a, b currentServer) int {
+		return cmp.Or(
+			b.CollectedAt.Compare(a.CollectedAt),
+			comparePlayers(a.PlayersCount, b.PlayersCount, params.PlayersOrderAsc),
+			cmp.Compare(a.Host, b.Host),
+		)
+	})
+
+	return lo.Map(paginate(servers, params.Limit, params.Offset), bindServerSummary), nil
+}
+
+// ListServerSummariesPage ...
+func (r *Repository) ListServerSummariesPage(_ context.Context, params domain.ListServerSummariesPageParams) (domain.ServerSummariesPage, error) {
+	if err := params.Validate(); err != nil {
+		return domain.ServerSummariesPage{}, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	servers := r.currentServers(params.Multiplayer, params.IncludeOffline)
+
+	page := domain.ServerSummariesPage{
+		Total: uint64(len(servers)),
+	}
+
+	compare := func(a, b currentServer) int {
+		return cmp.Or(comparePlayers(a.PlayersCount, b.PlayersCount, params.PlayersOrderAsc), cmp.Compare(a.Host, b.Host))
+	}
+
+	slices.SortFunc(servers, compare)
+
+	if params.After != nil {
+		after := currentServer{Server: domain.Server{PlayersCount: params.After.PlayersCount, Host: params.After.Host}}
+		servers = lo.Filter(servers, func(server currentServer, _ int) bool {
+			return compare(server, after) > 0
+		})
+	}
+
+	if len(servers) > int(params.Limit) {
+		servers = servers[:params.Limit]
+
+		last := servers[len(servers)-1]
+		page.NextCursor = params.NextCursor(last.PlayersCount, last.Host)
+	}
+
+	page.Servers = lo.Map(servers, bindServerSummary)
+
+	return page, nil
+}
+
+// SearchServers ...
+func (r *Repository) SearchServers(_ context.Context, params domain.SearchServersParams) (domain.ServerSearchResult, error) {
+	if err := params.Validate(); err != nil {
+		return domain.ServerSearchResult{}, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	servers := lo.Filter(r.currentServers(params.Multiplayer, true), func(server currentServer, _ int) bool {
+		switch {
+		case params.Query != "" && !strings.Contains(strings.ToLower(server.Name), strings.ToLower(params.Query)):
+			return false
+		case params.Language != "" && server.Language != params.Language:
+			return false
+		case params.Gamemode != "" && server.Gamemode != params.Gamemode:
+			return false
+		case params.MinPlayers != nil && server.PlayersCount < *params.MinPlayers:
+			return false
+		case params.MaxPlayers != nil && server.PlayersCount > *params.MaxPlayers:
+			return false
+		case params.Online == domain.ServerOnlineFilterOnline && !server.online:
+			return false
+		case params.Online == domain.ServerOnlineFilterOffline && server.online:
+			return false
+		default:
+			return true
+		}
+	})
+
+	slices.SortFunc(servers, func(a, b currentServer) int {
+		return cmp.Or(cmp.Compare(b.PlayersCount, a.PlayersCount), cmp.Compare(a.Host, b.Host))
+	})
+
+	return domain.ServerSearchResult{
+		Servers: lo.Map(paginate(servers, params.Limit, params.Offset), bindServerSummary),
+		Total:   uint64(len(servers)),
+		Facets: domain.ServerFacets{
+			Languages: countFacet(servers, func(server currentServer) string { return server.Language }),
+			Gamemodes: countFacet(servers, func(server currentServer) string { return server.Gamemode }),
+		},
+	}, nil
+}
+
+// ListTrendingServers ...
+func (r *Repository) ListTrendingServers(_ context.Context, params domain.ListTrendingServersParams) ([]domain.TrendingServer, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		to    = time.Now()
+		split = to.Add(-params.Window.Duration())
+		from  = split.Add(-params.Window.Duration())
+	)
+
+	type window struct {
+		sum   int64
+		count int64
+	}
+
+	before, after := make(map[string]window), make(map[string]window)
+
+	for key, playersCount := range r.samples {
+		if key.multiplayer != params.Multiplayer || key.collectedAt < from.Unix() || key.collectedAt >= to.Unix() {
+			continue
+		}
+
+		windows := after
+		if key.collectedAt < split.Unix() {
+			windows = before
+		}
+
+		w := windows[key.host]
+		w.sum += int64(playersCount)
+		w.count++
+		windows[key.host] = w
+	}
+
+	avg := func(w window) float64 {
+		if w.count == 0 {
+			return 0
+		}
+
+		return float64(w.sum) / float64(w.count)
+	}
+
+	hosts := lo.Union(slices.Collect(maps.Keys(before)), slices.Collect(maps.Keys(after)))
+
+	servers := lo.Map(hosts, func(host string, _ int) domain.TrendingServer {
+		server := domain.TrendingServer{
+			Host:          host,
+			Name:          r.servers[serverKey{multiplayer: params.Multiplayer, host: host}].Name,
+			PlayersBefore: avg(before[host]),
+			PlayersAfter:  avg(after[host]),
+		}
+		server.PlayersChange = server.PlayersAfter - server.PlayersBefore
+
+		if server.PlayersBefore != 0 {
+			server.RelativeChange = lo.ToPtr(server.PlayersChange / server.PlayersBefore)
+		}
+
+		return server
+	})
+
+	slices.SortFunc(servers, func(a, b domain.TrendingServer) int {
+		if params.OrderBy == domain.TrendingOrderRelative {
+			return cmp.Or(compareRelativeChange(a.RelativeChange, b.RelativeChange), cmp.Compare(a.Host, b.Host))
+		}
+
+		return cmp.Or(cmp.Compare(b.PlayersChange, a.PlayersChange), cmp.Compare(a.Host, b.Host))
+	})
+
+	return paginate(servers, params.Limit, params.Offset), nil
+}
+
+// GetServer ...
+func (r *Repository) GetServer(_ context.Context, multiplayer domain.Multiplayer, host string) (domain.Server, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.getServer(multiplayer, host)
+}
+
+// ListServerStatistics ...
+func (r *Repository) ListServerStatistics(_ context.Context, params domain.ListServerStatisticsParams) ([]domain.ServerStatisticPoint, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	buckets := make(map[time.Time][]int32)
+
+	for key, playersCount := range r.samples {
+		if key.multiplayer != params.Multiplayer || key.host != params.Host || !inTimeRange(key.collectedAt, params.TimeRange) {
+			continue
+		}
+
+		bucket := params.Precision.BucketStart(time.Unix(key.collectedAt, 0))
+		buckets[bucket] = append(buckets[bucket], playersCount)
+	}
+
+	if len(buckets) == 0 {
+		return nil, domain.ErrServerNotFound
+	}
+
+	var points []domain.ServerStatisticPoint
+
+	for bucket, playersCounts := range buckets {
+		points = append(points, statisticPoint(bucket, playersCounts, params.Aggregates))
+	}
+
+	if params.Fill {
+		points = r.fillPoints(points, params)
+	}
+
+	slices.SortFunc(points, func(a, b domain.ServerStatisticPoint) int {
+		return b.CollectedAt.Compare(a.CollectedAt)
+	})
+
+	return points, nil
+}
+
+// fillPoints returns points of every bucket of the time range.
+// Missing buckets have status computed by collection runs of the multiplayer.
+func (r *Repository) fillPoints(points []domain.ServerStatisticPoint, params domain.ListServerStatisticsParams) []domain.ServerStatisticPoint {
+	present := lo.SliceToMap(points, func(point domain.ServerStatisticPoint) (time.Time, bool) {
+		return point.CollectedAt, true
+	})
+
+	runs := make(map[time.Time]bool)
+
+	for key := range r.runs {
+		if key.multiplayer != params.Multiplayer {
+			continue
+		}
+
+		collectedAt := time.Unix(key.collectedAt, 0)
+		if collectedAt.Before(params.TimeRange.From) || !collectedAt.Before(params.TimeRange.To) {
+			continue
+		}
+
+		bucket := params.Precision.BucketStart(collectedAt)
+		runs[bucket] = runs[bucket] || key.status == domain.CollectionRunStatusOK
+	}
+
+	for bucket := params.Precision.BucketStart(params.TimeRange.From); bucket.Before(params.TimeRange.To); bucket = params.Precision.NextBucket(bucket) {
+		if present[bucket] {
+			continue
+		}
+
+		status := domain.ServerStatisticStatusUnknown
+		if succeeded, ok := runs[bucket]; ok {
+			status = domain.ServerStatisticStatusCollectionFailed
+			if succeeded {
+				status = domain.ServerStatisticStatusOffline
+			}
+		}
+
+		points = append(points, domain.ServerStatisticPoint{
+			Status:      status,
+			CollectedAt: bucket,
+		})
+	}
+
+	return points
+}
+
+// GetServerUptime ...
+func (r *Repository) GetServerUptime(_ context.Context, multiplayer domain.Multiplayer, host string) (domain.ServerUptime, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	server := serverKey{multiplayer: multiplayer, host: host}
+
+	var firstSeenAt, lastSeenAt int64
+
+	for key := range r.samples {
+		if key.serverKey != server {
+			continue
+		}
+
+		if firstSeenAt == 0 || key.collectedAt < firstSeenAt {
+			firstSeenAt = key.collectedAt
+		}
+
+		lastSeenAt = max(lastSeenAt, key.collectedAt)
+	}
+
+	if lastSeenAt == 0 {
+		return domain.ServerUptime{}, domain.ErrServerNotFound
+	}
+
+	now := time.Now()
+
+	var runs []domain.ServerRunPresence
+
+	for key := range r.runs {
+		if key.multiplayer != multiplayer || key.status != domain.CollectionRunStatusOK || key.collectedAt < now.Add(-domain.ServerUptimeMaxWindow).Unix() {
+			continue
+		}
+
+		_, present := r.samples[sampleKey{serverKey: server, collectedAt: key.collectedAt}]
+
+		runs = append(runs, domain.ServerRunPresence{
+			CollectedAt: time.Unix(key.collectedAt, 0).UTC(),
+			Present:     present,
+		})
+	}
+
+	slices.SortFunc(runs, func(a, b domain.ServerRunPresence) int {
+		return a.CollectedAt.Compare(b.CollectedAt)
+	})
+
+	return domain.NewServerUptime(now, time.Unix(firstSeenAt, 0).UTC(), time.Unix(lastSeenAt, 0).UTC(), runs), nil
+}
+
+// ListServerMetadata returns the last known metadata of all servers of the multiplayer.
+func (r *Repository) ListServerMetadata(_ context.Context, multiplayer domain.Multiplayer) ([]domain.Server, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var servers []domain.Server
+
+	for key, server := range r.servers {
+		if key.multiplayer != multiplayer {
+			continue
+		}
+
+		servers = append(servers, domain.Server{
+			Multiplayer: server.Multiplayer,
+			Host:        server.Host,
+			Name:        server.Name,
+			URL:         server.URL,
+			Gamemode:    server.Gamemode,
+			Language:    server.Language,
+		})
+	}
+
+	return servers, nil
+}
+
+// InsertServerChanges ...
+func (r *Repository) InsertServerChanges(_ context.Context, changes []domain.ServerChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, change := range changes {
+		change.ChangedAt = truncate(change.ChangedAt)
+
+		key := changeKey{
+			serverKey: serverKey{multiplayer: change.Multiplayer, host: change.Host},
+			field:     change.Field,
+			changedAt: change.ChangedAt.Unix(),
+		}
+
+		if _, ok := r.changes[key]; !ok {
+			r.changes[key] = change
+		}
+	}
+
+	return nil
+}
+
+// ListServerChanges ...
+func (r *Repository) ListServerChanges(_ context.Context, params domain.ListServerChangesParams) ([]domain.ServerChange, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var changes []domain.ServerChange
+
+	for key, change := range r.changes {
+		if key.multiplayer == params.Multiplayer && key.host == params.Host {
+			changes = append(changes, change)
+		}
+	}
+
+	if len(changes) == 0 && params.Offset == 0 {
+		// server without changes is not distinguishable from unknown one by history, so it is checked separately.
+		if _, err := r.getServer(params.Multiplayer, params.Host); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b domain.ServerChange) int {
+		return cmp.Or(b.ChangedAt.Compare(a.ChangedAt), cmp.Compare(a.Field, b.Field))
+	})
+
+	return paginate(changes, params.Limit, params.Offset), nil
+}
+
+// InsertCollectionRun ...
+func (r *Repository) InsertCollectionRun(_ context.Context, run domain.CollectionRun) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run.CollectedAt = truncate(run.CollectedAt)
+
+	r.runs[runKey{multiplayer: run.Multiplayer, collectedAt: run.CollectedAt.Unix(), status: run.Status}] = run
+
+	return nil
+}
+
+func (r *Repository) getServer(multiplayer domain.Multiplayer, host string) (domain.Server, error) {
+	server, ok := r.servers[serverKey{multiplayer: multiplayer, host: host}]
+	if !ok {
+		return domain.Server{}, domain.ErrServerNotFound
+	}
+
+	server.PlayersCount = r.latestOnline(multiplayer)[host]
+	server.Tags = slices.Clone(server.Tags)
+
+	return server, nil
+}
+
+// latestSnapshots returns the latest snapshot time of every multiplayer.
+func (r *Repository) latestSnapshots() map[domain.Multiplayer]int64 {
+	minCollectedAt := time.Now().Add(-latestSnapshotMaxAge).Unix()
+
+	snapshots := make(map[domain.Multiplayer]int64)
+
+	for key := range r.samples {
+		if key.collectedAt >= minCollectedAt && key.collectedAt > snapshots[key.multiplayer] {
+			snapshots[key.multiplayer] = key.collectedAt
+		}
+	}
+
+	return snapshots
+}
+
+// latestOnline returns players count of servers online of the multiplayer in its latest snapshot.
+func (r *Repository) latestOnline(multiplayer domain.Multiplayer) map[string]int32 {
+	online := make(map[string]int32)
+
+	snapshotAt, ok := r.latestSnapshots()[multiplayer]
+	if !ok {
+		return online
+	}
+
+	for key, playersCount := range r.samples {
+		if key.multiplayer == multiplayer && key.collectedAt == snapshotAt {
+			online[key.host] = playersCount
+		}
+	}
+
+	return online
+}
+
+// currentServer is a server with its current players count.
+// CollectedAt of the server is a time of the latest snapshot, that server was present in.
+type currentServer struct {
+	domain.Server
+
+	online bool
+}
+
+// currentServers returns servers of the multiplayer with their current players count.
+// Servers missing from the latest snapshot are returned only with includeOffline.
+func (r *Repository) currentServers(multiplayer domain.Multiplayer, includeOffline bool) []currentServer {
+	online := r.latestOnline(multiplayer)
+
+	var servers []currentServer
+
+	for key, server := range r.servers {
+		if key.multiplayer != multiplayer {
+			continue
+		}
+
+		playersCount, isOnline := online[key.host]
+		if !isOnline && !includeOffline {
+			continue
+		}
+
+		server.PlayersCount = playersCount
+		servers = append(servers, currentServer{Server: server, online: isOnline})
+	}
+
+	return servers
+}
+
+// statisticPoint returns point of the bucket with average players count and requested aggregates.
+func statisticPoint(bucket time.Time, playersCounts []int32, aggregates []domain.ServerStatisticAggregate) domain.ServerStatisticPoint {
+	slices.Sort(playersCounts)
+
+	var sum int64
+	for _, playersCount := range playersCounts {
+		sum += int64(playersCount)
+	}
+
+	point := domain.ServerStatisticPoint{
+		Status:       domain.ServerStatisticStatusOnline,
+		PlayersCount: int32(sum / int64(len(playersCounts))), //nolint:gosec
+		CollectedAt:  bucket,
+	}
+
+	for _, aggregate := range aggregates {
+		switch aggregate {
+		case domain.ServerStatisticAggregateMin:
+			point.PlayersMin = playersCounts[0]
+		case domain.ServerStatisticAggregateMax:
+			point.PlayersMax = playersCounts[len(playersCounts)-1]
+		case domain.ServerStatisticAggregateP50:
+			point.PlayersP50 = nearestRank(playersCounts, 50)
+		case domain.ServerStatisticAggregateP95:
+			point.PlayersP95 = nearestRank(playersCounts, 95)
+		case domain.ServerStatisticAggregateCount:
+			point.Samples = uint64(len(playersCounts))
+		case domain.ServerStatisticAggregateAvg:
+		}
+	}
+
+	return point
+}
+
+// nearestRank returns nearest-rank percentile of sorted values.
+func nearestRank(sorted []int32, percent int) int32 {
+	return sorted[(len(sorted)*percent+99)/100-1]
+}
+
+func countFacet(servers []currentServer, value func(server currentServer) string) []domain.FacetValue {
+	counts := lo.CountValuesBy(servers, value)
+
+	facets := make([]domain.FacetValue, 0, len(counts))
+	for facetValue, count := range counts {
+		facets = append(facets, domain.FacetValue{
+			Value: facetValue,
+			Count: uint64(count), //nolint:gosec
+		})
+	}
+
+	slices.SortFunc(facets, func(a, b domain.FacetValue) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Value, b.Value))
+	})
+
+	return facets
+}
+
+func bindServerSummary(server currentServer, _ int) domain.ServerSummary {
+	return domain.ServerSummary{
+		Host:         server.Host,
+		Name:         server.Name,
+		PlayersCount: server.PlayersCount,
+		Online:       server.online,
+		LastSeenAt:   server.CollectedAt,
+	}
+}
+
+func comparePlayers[T cmp.Ordered](a, b T, asc bool) int {
+	if asc {
+		return cmp.Compare(a, b)
+	}
+
+	return cmp.Compare(b, a)
+}
+
+// compareRelativeChange orders relative changes descending with nil last.
+func compareRelativeChange(a, b *float64) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	default:
+		return cmp.Compare(*b, *a)
+	}
+}
+
+func paginate[T any](items []T, limit, offset int32) []T {
+	if int(offset) >= len(items) {
+		return nil
+	}
+
+	return items[offset:min(int(offset+limit), len(items))]
+}
+
+// inTimeRange reports whether unix time is strictly inside the time range.
+func inTimeRange(collectedAt int64, timeRange domain.TimeRange) bool {
+	return collectedAt > timeRange.From.Unix() && collectedAt < timeRange.To.Unix()
+}
+
+// truncate returns time in UTC with seconds precision.
+func truncate(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Second)
+}
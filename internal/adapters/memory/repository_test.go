@@ -0,0 +1,16 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package memory
+
+import (
+	"testing"
+
+	"github.com/EpicStep/gdatum/internal/domain/repotest"
+)
+
+func TestRepositoryConformance(t *testing.T) {
+	t.Parallel()
+
+	repotest.Run(t, New())
+}
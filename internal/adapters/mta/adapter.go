@@ -0,0 +1,163 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package mta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/clients/mta"
+)
+
+const (
+	defaultConcurrency  = 64
+	defaultQueryTimeout = 5 * time.Second
+	// defaultMaxFailedPercent tolerates servers, that block ASE port, as SA-MP adapter does.
+	defaultMaxFailedPercent = 90
+)
+
+var errTooManyFailedProbes = errors.New("too many servers failed to respond to the probe")
+
+type client interface {
+	Servers(ctx context.Context) (mta.Servers, error)
+	Query(ctx context.Context, address string) (mta.Server, error)
+}
+
+// Adapter ...
+type Adapter struct {
+	client           client
+	multiplayer      domain.Multiplayer
+	probe            bool
+	concurrency      int
+	queryTimeout     time.Duration
+	maxFailedPercent int
+}
+
+// NewOpts ...
+type NewOpts struct {
+	// Probe enables querying every server by ASE protocol, to get fresh info instead of master server one.
+	Probe bool
+	// Concurrency is a maximum number of servers probed at the same time.
+	Concurrency int
+	// QueryTimeout is a timeout of probing single server.
+	QueryTimeout time.Duration
+	// MaxFailedPercent is a percent of listed servers, that may fail to respond to the probe before collection fails,
+	// so network outage is not collected as stale master server info.
+	MaxFailedPercent int
+}
+
+func (o *NewOpts) setDefaults() {
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultConcurrency
+	}
+
+	if o.QueryTimeout <= 0 {
+		o.QueryTimeout = defaultQueryTimeout
+	}
+
+	if o.MaxFailedPercent <= 0 || o.MaxFailedPercent > 100 {
+		o.MaxFailedPercent = defaultMaxFailedPercent
+	}
+}
+
+// New returns new Adapter, that collects servers as multiplayer.
+func New(client client, multiplayer domain.Multiplayer, opts NewOpts) *Adapter {
+	opts.setDefaults()
+
+	return &Adapter{
+		client:           client,
+		multiplayer:      multiplayer,
+		probe:            opts.Probe,
+		concurrency:      opts.Concurrency,
+		queryTimeout:     opts.QueryTimeout,
+		maxFailedPercent: opts.MaxFailedPercent,
+	}
+}
+
+// Servers ...
+func (a *Adapter) Servers(ctx context.Context, collectedAt time.Time) ([]domain.Server, error) {
+	servers, err := a.client.Servers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.probe {
+		// servers may be shared with client cache, so they are cloned before probing.
+		servers = slices.Clone(servers)
+
+		if err = a.probeServers(ctx, servers); err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Map(servers, func(server mta.Server, _ int) domain.Server {
+		return domain.Server{
+			Multiplayer:  a.multiplayer,
+			Host:         server.Address,
+			Name:         server.Name,
+			Gamemode:     server.Gamemode,
+			Map:          server.Map,
+			PlayersCount: server.Players,
+			MaxPlayers:   server.MaxPlayers,
+			Version:      server.Version,
+			Passworded:   server.Passworded,
+			CollectedAt:  collectedAt,
+		}
+	}), nil
+}
+
+// probeServers replaces servers info with ASE responses, servers that didn't respond keep master server info.
+func (a *Adapter) probeServers(ctx context.Context, servers mta.Servers) error {
+	var (
+		failed    int
+		lastErr   error
+		failedMux sync.Mutex
+	)
+
+	var eg errgroup.Group
+	eg.SetLimit(a.concurrency)
+
+	for i := range servers {
+		eg.Go(func() error {
+			qCtx, cancel := context.WithTimeout(ctx, a.queryTimeout)
+			defer cancel()
+
+			server, err := a.client.Query(qCtx, servers[i].Address)
+			if err != nil {
+				failedMux.Lock()
+				defer failedMux.Unlock()
+
+				// server is offline or blocks ASE port.
+				failed++
+				lastErr = err
+
+				return nil
+			}
+
+			servers[i] = server
+
+			return nil
+		})
+	}
+
+	_ = eg.Wait() //nolint:errcheck
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if failed*100 > len(servers)*a.maxFailedPercent {
+		return fmt.Errorf("%w: %d of %d: %w", errTooManyFailedProbes, failed, len(servers), lastErr)
+	}
+
+	return nil
+}
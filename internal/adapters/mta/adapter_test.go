@@ -0,0 +1,122 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package mta
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/clients/mta"
+)
+
+var errTimeout = errors.New("timeout")
+
+type fakeClient struct {
+	listed mta.Servers
+	// responding are addresses, that respond to the probe.
+	responding map[string]bool
+}
+
+func (c fakeClient) Servers(context.Context) (mta.Servers, error) {
+	return c.listed, nil
+}
+
+func (c fakeClient) Query(_ context.Context, address string) (mta.Server, error) {
+	if !c.responding[address] {
+		return mta.Server{}, errTimeout
+	}
+
+	return mta.Server{
+		Address:    address,
+		Name:       "probed " + address,
+		Map:        "probed map",
+		Passworded: lo.ToPtr(true),
+	}, nil
+}
+
+func TestAdapter_Servers(t *testing.T) {
+	t.Parallel()
+
+	// list of version 0 carries no info, so password flag is unknown.
+	listed := mta.Servers{
+		{Address: "a", Name: "listed a", Map: "listed map"},
+		{Address: "b", Name: "listed b"},
+		{Address: "c", Name: "listed c"},
+		{Address: "d", Name: "listed d"},
+	}
+
+	tests := []struct {
+		name       string
+		responding map[string]bool
+		opts       NewOpts
+		want       map[string]domain.Server
+		wantErr    bool
+	}{
+		{
+			name: "WithoutProbe",
+			want: map[string]domain.Server{
+				"a": {Name: "listed a", Map: "listed map"},
+				"b": {Name: "listed b"},
+				"c": {Name: "listed c"},
+				"d": {Name: "listed d"},
+			},
+		},
+		{
+			name:       "SomeProbesFailed",
+			responding: map[string]bool{"a": true},
+			opts:       NewOpts{Probe: true},
+			want: map[string]domain.Server{
+				"a": {Name: "probed a", Map: "probed map", Passworded: lo.ToPtr(true)},
+				"b": {Name: "listed b"},
+				"c": {Name: "listed c"},
+				"d": {Name: "listed d"},
+			},
+		},
+		{
+			name:    "AllProbesFailed",
+			opts:    NewOpts{Probe: true},
+			wantErr: true,
+		},
+		{
+			name:       "ProbesFailedOverThreshold",
+			responding: map[string]bool{"a": true, "b": true},
+			opts:       NewOpts{Probe: true, MaxFailedPercent: 25},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			adapter := New(fakeClient{listed: listed, responding: tt.responding}, "mta-ru", tt.opts)
+
+			servers, err := adapter.Servers(t.Context(), time.Time{})
+			if tt.wantErr {
+				require.ErrorIs(t, err, errTooManyFailedProbes)
+				assert.ErrorIs(t, err, errTimeout)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			got := make(map[string]domain.Server, len(servers))
+			for _, server := range servers {
+				assert.Equal(t, domain.Multiplayer("mta-ru"), server.Multiplayer)
+
+				got[server.Host] = domain.Server{Name: server.Name, Map: server.Map, Passworded: server.Passworded}
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/repository/postgres"
+)
+
+type postgresStore interface {
+	InsertServers(ctx context.Context, servers []postgres.Server) error
+	ListMultiplayerSummaries(ctx context.Context, playersOrderAsc bool) ([]postgres.MultiplayerSummary, error)
+	ListMultiplayerStatistics(ctx context.Context, params domain.ListMultiplayerStatisticsParams) ([]postgres.MultiplayerStatisticPoint, error)
+	ListServerSummaries(ctx context.Context, params domain.ListServerSummariesParams) ([]postgres.ServerSummary, error)
+	ListServerSummariesPage(ctx context.Context, params domain.ListServerSummariesPageParams) ([]postgres.ServerSummary, error)
+	CountServers(ctx context.Context, multiplayer domain.Multiplayer, includeOffline bool) (uint64, error)
+	SearchServers(ctx context.Context, params domain.SearchServersParams) ([]postgres.ServerSummary, error)
+	ListServerFacets(ctx context.Context, params domain.SearchServersParams) ([]postgres.ServerFacetValue, error)
+	ListTrendingServers(ctx context.Context, params domain.ListTrendingServersParams) ([]postgres.TrendingServer, error)
+	GetServer(ctx context.Context, multiplayer domain.Multiplayer, host string) (postgres.Server, error)
+	ListServerStatistics(ctx context.Context, params domain.ListServerStatisticsParams) ([]postgres.ServerStatisticPoint, error)
+	ListServerMetadata(ctx context.Context, multiplayer domain.Multiplayer) ([]postgres.Server, error)
+	InsertServerChanges(ctx context.Context, changes []postgres.ServerChange) error
+	ListServerChanges(ctx context.Context, params domain.ListServerChangesParams) ([]postgres.ServerChange, error)
+	InsertCollectionRun(ctx context.Context, run postgres.CollectionRun) error
+	GetServerSeen(ctx context.Context, multiplayer domain.Multiplayer, host string) (postgres.ServerSeen, error)
+	ListServerRunPresence(ctx context.Context, multiplayer domain.Multiplayer, host string, from time.Time) ([]postgres.ServerRunPresence, error)
+}
+
+// Adapter ...
+type Adapter struct {
+	store postgresStore
+}
+
+// New returns new Postgres adapter.
+func New(store postgresStore) *Adapter {
+	return &Adapter{
+		store: store,
+	}
+}
+
+// InsertSnapshot ...
+func (a *Adapter) InsertSnapshot(ctx context.Context, snapshot domain.Snapshot) error {
+	pgServers := lo.Map(snapshot.Servers, func(srv domain.Server, _ int) postgres.Server {
+		return postgres.Server{
+			Multiplayer:  string(srv.Multiplayer),
+			Host:         srv.Host,
+			Name:         srv.Name,
+			URL:          srv.URL,
+			Gamemode:     srv.Gamemode,
+			Language:     srv.Language,
+			PlayersCount: srv.PlayersCount,
+			MaxPlayers:   srv.MaxPlayers,
+			PeakPlayers:  srv.PeakPlayers,
+			Map:          srv.Map,
+			Version:      srv.Version,
+			Passworded:   srv.Passworded,
+			Tags:         srv.Tags,
+			CollectedAt:  srv.CollectedAt,
+		}
+	})
+
+	return a.store.InsertServers(ctx, pgServers)
+}
+
+// ListMultiplayerSummaries ...
+func (a *Adapter) ListMultiplayerSummaries(ctx context.Context, playersOrderAsc bool) ([]domain.MultiplayerSummary, error) {
+	summaries, err := a.store.ListMultiplayerSummaries(ctx, playersOrderAsc)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(summaries, func(summary postgres.MultiplayerSummary, _ int) domain.MultiplayerSummary {
+		return domain.MultiplayerSummary{
+			Name:         domain.Multiplayer(summary.Multiplayer),
+			PlayersCount: summary.PlayersCount,
+		}
+	}), nil
+}
+
+// ListMultiplayerStatistics ...
+func (a *Adapter) ListMultiplayerStatistics(ctx context.Context, params domain.ListMultiplayerStatisticsParams) ([]domain.MultiplayerStatisticPoint, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	statistics, err := a.store.ListMultiplayerStatistics(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statistics) == 0 {
+		return nil, domain.ErrMultiplayerNotFound
+	}
+
+	return lo.Map(statistics, func(statistic postgres.MultiplayerStatisticPoint, _ int) domain.MultiplayerStatisticPoint {
+		return domain.MultiplayerStatisticPoint{
+			PlayersCount: statistic.PlayersCount,
+			ServersCount: statistic.ServersCount,
+			CollectedAt:  statistic.CollectedAt,
+		}
+	}), nil
+}
+
+// GetServer ...
+func (a *Adapter) GetServer(ctx context.Context, multiplayer domain.Multiplayer, host string) (domain.Server, error) {
+	pgServer, err := a.store.GetServer(ctx, multiplayer, host)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Server{}, domain.ErrServerNotFound
+		}
+
+		return domain.Server{}, err
+	}
+
+	return domain.Server{
+		Multiplayer:  domain.Multiplayer(pgServer.Multiplayer),
+		Host:         pgServer.Host,
+		Name:         pgServer.Name,
+		URL:          pgServer.URL,
+		Gamemode:     pgServer.Gamemode,
+		Language:     pgServer.Language,
+		PlayersCount: pgServer.PlayersCount,
+		MaxPlayers:   pgServer.MaxPlayers,
+		PeakPlayers:  pgServer.PeakPlayers,
+		Map:          pgServer.Map,
+		Version:      pgServer.Version,
+		Passworded:   pgServer.Passworded,
+		Tags:         pgServer.Tags,
+		CollectedAt:  pgServer.CollectedAt,
+	}, nil
+}
+
+// GetServerUptime ...
+func (a *Adapter) GetServerUptime(ctx context.Context, multiplayer domain.Multiplayer, host string) (domain.ServerUptime, error) {
+	seen, err := a.store.GetServerSeen(ctx, multiplayer, host)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ServerUptime{}, domain.ErrServerNotFound
+		}
+
+		return domain.ServerUptime{}, err
+	}
+
+	now := time.Now()
+
+	runs, err := a.store.ListServerRunPresence(ctx, multiplayer, host, now.Add(-domain.ServerUptimeMaxWindow))
+	if err != nil {
+		return domain.ServerUptime{}, err
+	}
+
+	return domain.NewServerUptime(now, seen.FirstSeenAt, seen.LastSeenAt, lo.Map(runs, func(run postgres.ServerRunPresence, _ int) domain.ServerRunPresence {
+		return domain.ServerRunPresence{
+			CollectedAt: run.CollectedAt,
+			Present:     run.Present,
+		}
+	})), nil
+}
+
+// ListServerSummaries ...
+func (a *Adapter) ListServerSummaries(ctx context.Context, params domain.ListServerSummariesParams) ([]domain.ServerSummary, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	servers, err := a.store.ListServerSummaries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(servers, bindServerSummary), nil
+}
+
+// ListServerSummariesPage ...
+func (a *Adapter) ListServerSummariesPage(ctx context.Context, params domain.ListServerSummariesPageParams) (domain.ServerSummariesPage, error) {
+	if err := params.Validate(); err != nil {
+		return domain.ServerSummariesPage{}, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	// one more server is requested to know, whether the next page exists.
+	pageParams := params
+	pageParams.Limit++
+
+	servers, err := a.store.ListServerSummariesPage(ctx, pageParams)
+	if err != nil {
+		return domain.ServerSummariesPage{}, err
+	}
+
+	total, err := a.store.CountServers(ctx, params.Multiplayer, params.IncludeOffline)
+	if err != nil {
+		return domain.ServerSummariesPage{}, err
+	}
+
+	page := domain.ServerSummariesPage{
+		Total: total,
+	}
+
+	if len(servers) > int(params.Limit) {
+		servers = servers[:params.Limit]
+
+		last := servers[len(servers)-1]
+		page.NextCursor = params.NextCursor(last.PlayersCount, last.Host)
+	}
+
+	page.Servers = lo.Map(servers, bindServerSummary)
+
+	return page, nil
+}
+
+// SearchServers ...
+func (a *Adapter) SearchServers(ctx context.Context, params domain.SearchServersParams) (domain.ServerSearchResult, error) {
+	if err := params.Validate(); err != nil {
+		return domain.ServerSearchResult{}, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	servers, err := a.store.SearchServers(ctx, params)
+	if err != nil {
+		return domain.ServerSearchResult{}, err
+	}
+
+	facets, err := a.store.ListServerFacets(ctx, params)
+	if err != nil {
+		return domain.ServerSearchResult{}, err
+	}
+
+	result := bindServerFacets(facets)
+	result.Servers = lo.Map(servers, bindServerSummary)
+
+	return result, nil
+}
+
+// bindServerFacets returns search result with facets and total, that is counted by language facet,
+// because every server has exactly one language value.
+func bindServerFacets(facets []postgres.ServerFacetValue) domain.ServerSearchResult {
+	var result domain.ServerSearchResult
+
+	for _, facet := range facets {
+		value := domain.FacetValue{
+			Value: facet.Value,
+			Count: uint64(facet.Count), //nolint:gosec
+		}
+
+		switch facet.Facet {
+		case postgres.FacetLanguage:
+			result.Facets.Languages = append(result.Facets.Languages, value)
+			result.Total += value.Count
+		case postgres.FacetGamemode:
+			result.Facets.Gamemodes = append(result.Facets.Gamemodes, value)
+		}
+	}
+
+	return result
+}
+
+func bindServerSummary(server postgres.ServerSummary, _ int) domain.ServerSummary {
+	return domain.ServerSummary{
+		Host:         server.Host,
+		Name:         server.Name,
+		PlayersCount: server.PlayersCount,
+		Online:       server.Online,
+		LastSeenAt:   server.LastSeenAt,
+	}
+}
+
+// ListTrendingServers ...
+func (a *Adapter) ListTrendingServers(ctx context.Context, params domain.ListTrendingServersParams) ([]domain.TrendingServer, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	servers, err := a.store.ListTrendingServers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(servers, func(server postgres.TrendingServer, _ int) domain.TrendingServer {
+		return domain.TrendingServer{
+			Host:           server.Host,
+			Name:           server.Name,
+			PlayersBefore:  server.PlayersBefore,
+			PlayersAfter:   server.PlayersAfter,
+			PlayersChange:  server.PlayersChange,
+			RelativeChange: server.RelativeChange,
+		}
+	}), nil
+}
+
+// ListServerStatistics ...
+func (a *Adapter) ListServerStatistics(ctx context.Context, params domain.ListServerStatisticsParams) ([]domain.ServerStatisticPoint, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	statistics, err := a.store.ListServerStatistics(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	isOnline := func(statistic postgres.ServerStatisticPoint) bool {
+		return statistic.Status == string(domain.ServerStatisticStatusOnline)
+	}
+
+	if !lo.ContainsBy(statistics, isOnline) {
+		return nil, domain.ErrServerNotFound
+	}
+
+	return lo.Map(statistics, func(statistic postgres.ServerStatisticPoint, _ int) domain.ServerStatisticPoint {
+		if !isOnline(statistic) {
+			return domain.ServerStatisticPoint{
+				Status:      domain.ServerStatisticStatus(statistic.Status),
+				CollectedAt: statistic.CollectedAt,
+			}
+		}
+
+		return domain.ServerStatisticPoint{
+			Status:       domain.ServerStatisticStatusOnline,
+			PlayersCount: statistic.PlayersCount,
+			PlayersMin:   statistic.MinPlayersCount,
+			PlayersMax:   statistic.MaxPlayersCount,
+			PlayersP50:   statistic.P50PlayersCount,
+			PlayersP95:   statistic.P95PlayersCount,
+			Samples:      uint64(statistic.SamplesCount), //nolint:gosec
+			CollectedAt:  statistic.CollectedAt,
+		}
+	}), nil
+}
+
+// ListServerMetadata ...
+func (a *Adapter) ListServerMetadata(ctx context.Context, multiplayer domain.Multiplayer) ([]domain.Server, error) {
+	servers, err := a.store.ListServerMetadata(ctx, multiplayer)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(servers, func(server postgres.Server, _ int) domain.Server {
+		return domain.Server{
+			Multiplayer: domain.Multiplayer(server.Multiplayer),
+			Host:        server.Host,
+			Name:        server.Name,
+			URL:         server.URL,
+			Gamemode:    server.Gamemode,
+			Language:    server.Language,
+		}
+	}), nil
+}
+
+// InsertServerChanges ...
+func (a *Adapter) InsertServerChanges(ctx context.Context, changes []domain.ServerChange) error {
+	return a.store.InsertServerChanges(ctx, lo.Map(changes, func(change domain.ServerChange, _ int) postgres.ServerChange {
+		return postgres.ServerChange{
+			Multiplayer: string(change.Multiplayer),
+			Host:        change.Host,
+			Field:       string(change.Field),
+			OldValue:    change.OldValue,
+			NewValue:    change.NewValue,
+			ChangedAt:   change.ChangedAt,
+		}
+	}))
+}
+
+// ListServerChanges ...
+func (a *Adapter) ListServerChanges(ctx context.Context, params domain.ListServerChangesParams) ([]domain.ServerChange, error) {
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("params.Validate: %w", err)
+	}
+
+	changes, err := a.store.ListServerChanges(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changes) == 0 && params.Offset == 0 {
+		// server without changes is not distinguishable from unknown one by history, so it is checked separately.
+		if _, err = a.GetServer(ctx, params.Multiplayer, params.Host); err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Map(changes, func(change postgres.ServerChange, _ int) domain.ServerChange {
+		return domain.ServerChange{
+			Multiplayer: domain.Multiplayer(change.Multiplayer),
+			Host:        change.Host,
+			Field:       domain.ServerField(change.Field),
+			OldValue:    change.OldValue,
+			NewValue:    change.NewValue,
+			ChangedAt:   change.ChangedAt,
+		}
+	}), nil
+}
+
+// InsertCollectionRun ...
+func (a *Adapter) InsertCollectionRun(ctx context.Context, run domain.CollectionRun) error {
+	return a.store.InsertCollectionRun(ctx, postgres.CollectionRun{
+		Multiplayer:  string(run.Multiplayer),
+		CollectedAt:  run.CollectedAt,
+		Status:       string(run.Status),
+		ServersCount: run.ServersCount,
+		Error:        run.Error,
+	})
+}
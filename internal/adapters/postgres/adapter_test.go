@@ -0,0 +1,94 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum"
+	"github.com/EpicStep/gdatum/internal/config"
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/domain/repotest"
+	"github.com/EpicStep/gdatum/internal/infrastructure/repository/postgres"
+	"github.com/EpicStep/gdatum/internal/utils/migrations"
+)
+
+// refreshingRepository refreshes continuous aggregates after every insert,
+// otherwise inserted snapshots are not visible in them until the refresh policy runs.
+type refreshingRepository struct {
+	domain.Repository
+
+	db *pgxpool.Pool
+}
+
+func (r *refreshingRepository) InsertSnapshot(ctx context.Context, snapshot domain.Snapshot) error {
+	if err := r.Repository.InsertSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+
+	if _, err := r.db.Exec(ctx, "CALL refresh_continuous_aggregate('servers_online_daily', NULL, NULL)"); err != nil {
+		return fmt.Errorf("r.db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func TestAdapterConformance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		dsnEnv string
+		driver string
+	}{
+		{
+			name:   "Postgres",
+			dsnEnv: "TEST_POSTGRES_DSN",
+			driver: config.DatabaseDriverPostgres,
+		},
+		{
+			name:   "TimescaleDB",
+			dsnEnv: "TEST_TIMESCALEDB_DSN",
+			driver: config.DatabaseDriverTimescaleDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsn := os.Getenv(tt.dsnEnv)
+			if dsn == "" {
+				t.Skipf("%s is not set", tt.dsnEnv)
+			}
+
+			ctx := context.Background()
+
+			require.NoError(t, migrations.Run(ctx, tt.driver, dsn, gdatum.MigrationsFS))
+
+			poolConfig, err := pgxpool.ParseConfig(dsn)
+			require.NoError(t, err)
+
+			poolConfig.ConnConfig.RuntimeParams["timezone"] = "UTC"
+
+			db, err := pgxpool.NewWithConfig(ctx, poolConfig)
+			require.NoError(t, err)
+			t.Cleanup(db.Close)
+
+			var repo domain.Repository = New(postgres.New(db, postgres.Opts{
+				TimescaleDB: tt.driver == config.DatabaseDriverTimescaleDB,
+			}))
+
+			if tt.driver == config.DatabaseDriverTimescaleDB {
+				repo = &refreshingRepository{Repository: repo, db: db}
+			}
+
+			repotest.Run(t, repo)
+		})
+	}
+}
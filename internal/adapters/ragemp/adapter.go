@@ -19,13 +19,15 @@ type client interface {
 
 // Adapter ...
 type Adapter struct {
-	client client
+	client      client
+	multiplayer domain.Multiplayer
 }
 
-// New ...
-func New(client client) *Adapter {
+// New returns new Adapter, that collects servers as multiplayer.
+func New(client client, multiplayer domain.Multiplayer) *Adapter {
 	return &Adapter{
-		client: client,
+		client:      client,
+		multiplayer: multiplayer,
 	}
 }
 
@@ -38,12 +40,15 @@ func (a *Adapter) Servers(ctx context.Context, collectedAt time.Time) ([]domain.
 
 	return lo.MapToSlice(servers, func(host string, server ragemp.Server) domain.Server {
 		return domain.Server{
-			Multiplayer:  domain.MultiplayerRagemp,
+			Multiplayer:  a.multiplayer,
 			Host:         host,
 			Name:         server.Name,
+			URL:          server.URL,
 			Gamemode:     server.Gamemode,
 			Language:     server.Language,
 			PlayersCount: server.Players,
+			MaxPlayers:   server.MaxPlayers,
+			PeakPlayers:  server.Peak,
 			CollectedAt:  collectedAt,
 		}
 	}), nil
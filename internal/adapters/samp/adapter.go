@@ -0,0 +1,147 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package samp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/clients/samp"
+)
+
+const (
+	defaultConcurrency  = 64
+	defaultQueryTimeout = 5 * time.Second
+	// defaultMaxFailedPercent tolerates dead servers, that open.mp list keeps for a while.
+	defaultMaxFailedPercent = 90
+)
+
+var errTooManyFailedQueries = errors.New("too many servers failed to respond to the query")
+
+type client interface {
+	ListServers(ctx context.Context) (samp.ListedServers, error)
+	Query(ctx context.Context, address string) (samp.Server, error)
+}
+
+// Adapter ...
+type Adapter struct {
+	client           client
+	multiplayer      domain.Multiplayer
+	concurrency      int
+	queryTimeout     time.Duration
+	maxFailedPercent int
+}
+
+// NewOpts ...
+type NewOpts struct {
+	// Concurrency is a maximum number of servers queried at the same time.
+	Concurrency int
+	// QueryTimeout is a timeout of querying single server.
+	QueryTimeout time.Duration
+	// MaxFailedPercent is a percent of listed servers, that may fail to respond before collection fails,
+	// so network outage is not collected as all servers offline.
+	MaxFailedPercent int
+}
+
+func (o *NewOpts) setDefaults() {
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultConcurrency
+	}
+
+	if o.QueryTimeout <= 0 {
+		o.QueryTimeout = defaultQueryTimeout
+	}
+
+	if o.MaxFailedPercent <= 0 || o.MaxFailedPercent > 100 {
+		o.MaxFailedPercent = defaultMaxFailedPercent
+	}
+}
+
+// New returns new Adapter, that collects servers as multiplayer.
+func New(client client, multiplayer domain.Multiplayer, opts NewOpts) *Adapter {
+	opts.setDefaults()
+
+	return &Adapter{
+		client:           client,
+		multiplayer:      multiplayer,
+		concurrency:      opts.Concurrency,
+		queryTimeout:     opts.QueryTimeout,
+		maxFailedPercent: opts.MaxFailedPercent,
+	}
+}
+
+// Servers returns servers from open.mp list, that responded to the query.
+func (a *Adapter) Servers(ctx context.Context, collectedAt time.Time) ([]domain.Server, error) {
+	listed, err := a.client.ListServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result    = make([]domain.Server, 0, len(listed))
+		failed    int
+		lastErr   error
+		resultMux sync.Mutex
+	)
+
+	eg, eCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(a.concurrency)
+
+	for _, listedServer := range listed {
+		eg.Go(func() error {
+			qCtx, cancel := context.WithTimeout(eCtx, a.queryTimeout)
+			defer cancel()
+
+			server, err := a.client.Query(qCtx, listedServer.Address)
+
+			resultMux.Lock()
+			defer resultMux.Unlock()
+
+			if err != nil {
+				// server is offline or doesn't respond to the query.
+				failed++
+				lastErr = err
+
+				return nil
+			}
+
+			result = append(result, domain.Server{
+				Multiplayer:  a.multiplayer,
+				Host:         server.Address,
+				Name:         server.Hostname,
+				URL:          server.Rules["weburl"],
+				Gamemode:     server.Gamemode,
+				Language:     server.Language,
+				PlayersCount: server.Players,
+				MaxPlayers:   server.MaxPlayers,
+				Version:      server.Rules["version"],
+				Passworded:   lo.ToPtr(server.Passworded),
+				CollectedAt:  collectedAt,
+			})
+
+			return nil
+		})
+	}
+
+	if err = eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if failed*100 > len(listed)*a.maxFailedPercent {
+		return nil, fmt.Errorf("%w: %d of %d: %w", errTooManyFailedQueries, failed, len(listed), lastErr)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package samp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/clients/samp"
+)
+
+var errTimeout = errors.New("timeout")
+
+type fakeClient struct {
+	listed samp.ListedServers
+	// responding are addresses, that respond to the query.
+	responding map[string]bool
+}
+
+func (c fakeClient) ListServers(context.Context) (samp.ListedServers, error) {
+	return c.listed, nil
+}
+
+func (c fakeClient) Query(_ context.Context, address string) (samp.Server, error) {
+	if !c.responding[address] {
+		return samp.Server{}, errTimeout
+	}
+
+	return samp.Server{
+		Address:  address,
+		Hostname: "server " + address,
+	}, nil
+}
+
+func TestAdapter_Servers(t *testing.T) {
+	t.Parallel()
+
+	listed := samp.ListedServers{{Address: "a"}, {Address: "b"}, {Address: "c"}, {Address: "d"}}
+
+	tests := []struct {
+		name       string
+		responding map[string]bool
+		opts       NewOpts
+		wantHosts  []string
+		wantErr    bool
+	}{
+		{
+			name:       "AllResponded",
+			responding: map[string]bool{"a": true, "b": true, "c": true, "d": true},
+			wantHosts:  []string{"a", "b", "c", "d"},
+		},
+		{
+			name:       "SomeFailed",
+			responding: map[string]bool{"a": true},
+			wantHosts:  []string{"a"},
+		},
+		{
+			name:    "AllFailed",
+			wantErr: true,
+		},
+		{
+			name:       "FailedOverThreshold",
+			responding: map[string]bool{"a": true, "b": true},
+			opts:       NewOpts{MaxFailedPercent: 25},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			adapter := New(fakeClient{listed: listed, responding: tt.responding}, "samp-ru", tt.opts)
+
+			servers, err := adapter.Servers(t.Context(), time.Now())
+			if tt.wantErr {
+				require.ErrorIs(t, err, errTooManyFailedQueries)
+				assert.ErrorIs(t, err, errTimeout)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			hosts := make([]string, 0, len(servers))
+			for _, server := range servers {
+				assert.Equal(t, domain.Multiplayer("samp-ru"), server.Multiplayer)
+				hosts = append(hosts, server.Host)
+			}
+
+			assert.ElementsMatch(t, tt.wantHosts, hosts)
+		})
+	}
+}
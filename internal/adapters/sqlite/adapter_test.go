@@ -0,0 +1,37 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum"
+	"github.com/EpicStep/gdatum/internal/config"
+	"github.com/EpicStep/gdatum/internal/domain/repotest"
+	"github.com/EpicStep/gdatum/internal/infrastructure/repository/sqlite"
+	"github.com/EpicStep/gdatum/internal/utils/migrations"
+)
+
+func TestAdapterConformance(t *testing.T) {
+	t.Parallel()
+
+	dsn := filepath.Join(t.TempDir(), "gdatum.db")
+
+	require.NoError(t, migrations.Run(context.Background(), config.DatabaseDriverSQLite, dsn, gdatum.MigrationsFS))
+
+	db, err := sql.Open("sqlite", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	db.SetMaxOpenConns(1)
+
+	repotest.Run(t, New(sqlite.New(db)))
+}
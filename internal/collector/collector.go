@@ -5,80 +5,61 @@ package collector
 
 import (
 	"context"
-	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v5"
 	"go.uber.org/zap"
 
 	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/worker"
 )
 
 type collectFunc func(ctx context.Context, collectedAt time.Time) ([]domain.Server, error)
 
 type collectInstance struct {
 	Multiplayer domain.Multiplayer
+	Schedule    worker.Schedule
+	Jitter      time.Duration
+	RunOnStart  bool
+	MaxRuntime  time.Duration
 	Collect     collectFunc
 }
 
-func (h *Handler) collect(ctx context.Context) []domain.Server {
-	collectedAt := time.Now().Truncate(time.Hour)
+func (h *Handler) collect(ctx context.Context, collector collectInstance, collectedAt time.Time) (domain.Snapshot, error) {
+	var attempt int
 
-	var wg sync.WaitGroup
-
-	var result []domain.Server
-	var resultMux sync.Mutex
-
-	for _, collector := range h.collectors {
-		wg.Go(func() {
-			var attempt int
-
-			collectedServers, err := backoff.Retry(
-				ctx,
-				func() ([]domain.Server, error) {
-					collectedServers, err := collector.Collect(ctx, collectedAt)
-					if err != nil {
-						attempt++
-
-						h.logger.Error("failed to collect servers",
-							zap.String("multiplayer", string(collector.Multiplayer)),
-							zap.Int("attempt", attempt),
-							zap.Error(err),
-						)
-						return nil, err
-					}
-
-					return collectedServers, nil
-				},
-				backoff.WithBackOff(backoff.NewExponentialBackOff()),
-				backoff.WithMaxTries(3),
-			)
+	collectedServers, err := backoff.Retry(
+		ctx,
+		func() ([]domain.Server, error) {
+			collectedServers, err := collector.Collect(ctx, collectedAt)
 			if err != nil {
+				attempt++
+
 				h.logger.Error("failed to collect servers",
 					zap.String("multiplayer", string(collector.Multiplayer)),
+					zap.Int("attempt", attempt),
 					zap.Error(err),
 				)
-
-				h.metrics.RecordCollectionError(collector.Multiplayer)
-
-				return
+				return nil, err
 			}
 
-			h.logger.Debug("collected servers",
-				zap.String("multiplayer", string(collector.Multiplayer)),
-				zap.Int("count", len(collectedServers)),
-			)
-
-			h.metrics.RecordServersCollected(collector.Multiplayer, len(collectedServers))
+			return collectedServers, nil
+		},
+		backoff.WithBackOff(backoff.NewExponentialBackOff()),
+		backoff.WithMaxTries(3),
+	)
+	if err != nil {
+		h.metrics.RecordCollectionError(collector.Multiplayer)
 
-			resultMux.Lock()
-			defer resultMux.Unlock()
-
-			result = append(result, collectedServers...)
-		})
+		return domain.Snapshot{}, err
 	}
 
-	wg.Wait()
+	h.logger.Debug("collected servers",
+		zap.String("multiplayer", string(collector.Multiplayer)),
+		zap.Int("count", len(collectedServers)),
+	)
+
+	h.metrics.RecordServersCollected(collector.Multiplayer, len(collectedServers))
 
-	return result
+	return domain.NewSnapshot(collector.Multiplayer, collectedAt, collectedServers), nil
 }
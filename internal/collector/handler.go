@@ -11,14 +11,13 @@ import (
 	"github.com/cenkalti/backoff/v5"
 	"go.uber.org/zap"
 
-	altvAdapter "github.com/EpicStep/gdatum/internal/adapters/altv"
-	ragempAdapter "github.com/EpicStep/gdatum/internal/adapters/ragemp"
 	"github.com/EpicStep/gdatum/internal/domain"
-	altvClient "github.com/EpicStep/gdatum/internal/infrastructure/clients/altv"
-	ragempClient "github.com/EpicStep/gdatum/internal/infrastructure/clients/ragemp"
+	"github.com/EpicStep/gdatum/internal/infrastructure/worker"
 	backoffUtils "github.com/EpicStep/gdatum/internal/utils/backoff"
 )
 
+const recordRunTimeout = 10 * time.Second
+
 // Metrics is a metrics that Handler writes.
 type Metrics interface {
 	RecordServersCollected(multiplayer domain.Multiplayer, count int)
@@ -35,53 +34,93 @@ type Handler struct {
 	logger  *zap.Logger
 }
 
-// New ...
-func New(repo domain.Repository, metrics Metrics, logger *zap.Logger) *Handler {
+// Job is a collection job of a single source.
+type Job struct {
+	Multiplayer domain.Multiplayer
+	Schedule    worker.Schedule
+	Jitter      time.Duration
+	RunOnStart  bool
+	MaxRuntime  time.Duration
+	// Handle collects the source, tick is used as collection time, so every tick has own snapshot.
+	Handle func(ctx context.Context, tick time.Time) error
+}
+
+// New returns new Handler, that collects servers from sources enabled by configs.
+func New(repo domain.Repository, registry *Registry, configs []SourceConfig, metrics Metrics, logger *zap.Logger) (*Handler, error) {
 	if logger == nil {
 		logger = zap.L()
 	}
 
-	ragemp := ragempAdapter.New(ragempClient.New(ragempClient.NewOpts{})) // TODO: make general client to egress
-	altv := altvAdapter.New(altvClient.New(altvClient.NewOpts{}))
+	collectors, err := registry.build(configs)
+	if err != nil {
+		return nil, fmt.Errorf("registry.build: %w", err)
+	}
 
 	return &Handler{
-		collectors: []collectInstance{
-			{
-				Multiplayer: domain.MultiplayerRagemp,
-				Collect:     ragemp.Servers,
-			},
-			{
-				Multiplayer: domain.MultiplayerAltv,
-				Collect:     altv.Servers,
-			},
-		},
-		repo: repo,
+		collectors: collectors,
+		repo:       repo,
 
 		metrics: metrics,
 		logger:  logger,
+	}, nil
+}
+
+// Jobs returns collection jobs of enabled sources.
+func (h *Handler) Jobs() []Job {
+	jobs := make([]Job, 0, len(h.collectors))
+
+	for _, collector := range h.collectors {
+		jobs = append(jobs, Job{
+			Multiplayer: collector.Multiplayer,
+			Schedule:    collector.Schedule,
+			Jitter:      collector.Jitter,
+			RunOnStart:  collector.RunOnStart,
+			MaxRuntime:  collector.MaxRuntime,
+			Handle: func(ctx context.Context, tick time.Time) error {
+				return h.handle(ctx, collector, tick)
+			},
+		})
 	}
+
+	return jobs
 }
 
-// Handle ...
-func (h *Handler) Handle(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
-	defer cancel()
+func (h *Handler) handle(ctx context.Context, collector collectInstance, tick time.Time) error {
+	// tick is a fire time of the schedule, even for run on start, so snapshot ID is unique per tick
+	// and snapshots of the same tick are deduplicated.
+	collectedAt := tick
 
-	servers := h.collect(ctx)
+	snapshot, err := h.collect(ctx, collector, collectedAt)
+	if err != nil {
+		h.recordRun(ctx, domain.CollectionRun{
+			Multiplayer: collector.Multiplayer,
+			CollectedAt: collectedAt,
+			Status:      domain.CollectionRunStatusFailed,
+			Error:       err.Error(),
+		})
+
+		return fmt.Errorf("h.collect: %w", err)
+	}
+
+	// metadata is loaded before insert, because servers_info is updated by the insert itself.
+	metadata, metadataLoaded := h.lastMetadata(ctx, collector.Multiplayer)
 
 	var insertAttempt int
-	_, err := backoff.Retry(
+	_, err = backoff.Retry(
 		ctx,
 		backoffUtils.EmptyReturnOperation(func() error {
-			err := h.repo.InsertServers(ctx, servers)
+			// snapshot ID makes retry harmless, even if previous attempt was inserted partially.
+			err := h.repo.InsertSnapshot(ctx, snapshot)
 			if err != nil {
 				insertAttempt++
 				h.logger.Error("failed to insert servers",
+					zap.String("multiplayer", string(collector.Multiplayer)),
+					zap.String("snapshot_id", snapshot.ID),
 					zap.Int("attempt", insertAttempt),
 					zap.Error(err),
 				)
 
-				return fmt.Errorf("h.repo.InsertServers: %w", err)
+				return fmt.Errorf("h.repo.InsertSnapshot: %w", err)
 			}
 
 			return nil
@@ -91,8 +130,41 @@ func (h *Handler) Handle(ctx context.Context) error {
 	)
 	if err != nil {
 		h.metrics.RecordInsertError()
+		h.recordRun(ctx, domain.CollectionRun{
+			Multiplayer: collector.Multiplayer,
+			CollectedAt: collectedAt,
+			Status:      domain.CollectionRunStatusFailed,
+			Error:       err.Error(),
+		})
+
 		return fmt.Errorf("backoff.Retry: %w", err)
 	}
 
+	h.recordRun(ctx, domain.CollectionRun{
+		Multiplayer:  collector.Multiplayer,
+		CollectedAt:  collectedAt,
+		Status:       domain.CollectionRunStatusOK,
+		ServersCount: int32(len(snapshot.Servers)), //nolint:gosec
+	})
+
+	if metadataLoaded {
+		h.recordChanges(ctx, metadata, snapshot)
+	}
+
 	return nil
 }
+
+// recordRun inserts collection run, that distinguishes offline servers from failed collections in statistics.
+// It is recorded even if job context is done, e.g. when collection was failed by max runtime.
+func (h *Handler) recordRun(ctx context.Context, run domain.CollectionRun) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordRunTimeout)
+	defer cancel()
+
+	if err := h.repo.InsertCollectionRun(ctx, run); err != nil {
+		h.logger.Error("failed to insert collection run",
+			zap.String("multiplayer", string(run.Multiplayer)),
+			zap.String("status", string(run.Status)),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package collector
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+)
+
+// lastMetadata returns the last known metadata of the multiplayer servers by host.
+// History is not critical for collection, so error is only logged and changes are not detected then.
+func (h *Handler) lastMetadata(ctx context.Context, multiplayer domain.Multiplayer) (map[string]domain.Server, bool) {
+	servers, err := h.repo.ListServerMetadata(ctx, multiplayer)
+	if err != nil {
+		h.logger.Error("failed to list server metadata, changes are not detected",
+			zap.String("multiplayer", string(multiplayer)),
+			zap.Error(err),
+		)
+
+		return nil, false
+	}
+
+	metadata := make(map[string]domain.Server, len(servers))
+	for _, server := range servers {
+		metadata[server.Host] = server
+	}
+
+	return metadata, true
+}
+
+// recordChanges inserts metadata changes of servers, that were known before the snapshot.
+func (h *Handler) recordChanges(ctx context.Context, metadata map[string]domain.Server, snapshot domain.Snapshot) {
+	changes := detectChanges(metadata, snapshot.Servers)
+	if len(changes) == 0 {
+		return
+	}
+
+	if err := h.repo.InsertServerChanges(ctx, changes); err != nil {
+		h.logger.Error("failed to insert server changes",
+			zap.String("multiplayer", string(snapshot.Multiplayer)),
+			zap.String("snapshot_id", snapshot.ID),
+			zap.Int("count", len(changes)),
+			zap.Error(err),
+		)
+	}
+}
+
+func detectChanges(metadata map[string]domain.Server, servers []domain.Server) []domain.ServerChange {
+	var changes []domain.ServerChange
+
+	for _, server := range servers {
+		previous, ok := metadata[server.Host]
+		if !ok {
+			continue
+		}
+
+		changes = append(changes, server.Changes(previous)...)
+	}
+
+	return changes
+}
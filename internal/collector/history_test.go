@@ -0,0 +1,36 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+)
+
+func TestDetectChanges(t *testing.T) {
+	t.Parallel()
+
+	metadata := map[string]domain.Server{
+		"renamed":   {Host: "renamed", Name: "Old"},
+		"unchanged": {Host: "unchanged", Name: "Same"},
+	}
+
+	changes := detectChanges(metadata, []domain.Server{
+		{Host: "renamed", Name: "New"},
+		{Host: "unchanged", Name: "Same"},
+		{Host: "new", Name: "First seen"},
+	})
+
+	assert.Equal(t, []domain.ServerChange{
+		{
+			Host:     "renamed",
+			Field:    domain.ServerFieldName,
+			OldValue: "Old",
+			NewValue: "New",
+		},
+	}, changes)
+}
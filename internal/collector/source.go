@@ -0,0 +1,208 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/worker"
+)
+
+const (
+	defaultSourceInterval   = time.Hour
+	defaultSourceMaxRuntime = 5 * time.Minute
+	minSourceInterval       = time.Minute
+)
+
+var (
+	errUnknownSourceKind = errors.New("unknown source kind")
+	errDuplicateSource   = errors.New("duplicate source")
+	errIntervalTooShort  = errors.New("source interval is too short")
+)
+
+// Source is a source of multiplayer servers.
+type Source interface {
+	Servers(ctx context.Context, collectedAt time.Time) ([]domain.Server, error)
+}
+
+// SourceFactory creates Source of a single kind.
+type SourceFactory func(multiplayer domain.Multiplayer, opts SourceOptions) (Source, error)
+
+// SourceConfig is a config of enabled source.
+type SourceConfig struct {
+	// Name is a multiplayer name, that source collects.
+	Name string
+	// Kind is a name of registered factory, if empty Name is used.
+	Kind string
+	// Interval is a collection interval, default is one hour.
+	// Collections run at multiples of it, so collection time is aligned to it.
+	Interval time.Duration
+	// Schedule is an optional cron expression, that replaces Interval based schedule,
+	// then collection time is a fire time of the cron.
+	Schedule string
+	// Jitter is a maximum random delay of collection, that helps to stagger sources.
+	Jitter time.Duration
+	// RunOnStart enables collection right after start.
+	RunOnStart bool
+	// MaxRuntime is a timeout of single collection, default is five minutes.
+	MaxRuntime time.Duration
+	// Options are passed to the factory.
+	Options SourceOptions
+}
+
+// SourceOptions are source specific options.
+type SourceOptions map[string]string
+
+// String returns option value or defaultValue if option is not set.
+func (o SourceOptions) String(key, defaultValue string) string {
+	if value, ok := o[key]; ok && value != "" {
+		return value
+	}
+
+	return defaultValue
+}
+
+// Int returns option value as int or defaultValue if option is not set.
+func (o SourceOptions) Int(key string, defaultValue int) (int, error) {
+	value, ok := o[key]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+
+	result, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("option %q: %w", key, err)
+	}
+
+	return result, nil
+}
+
+// Bool returns option value as bool or defaultValue if option is not set.
+func (o SourceOptions) Bool(key string, defaultValue bool) (bool, error) {
+	value, ok := o[key]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("option %q: %w", key, err)
+	}
+
+	return result, nil
+}
+
+// Duration returns option value as time.Duration or defaultValue if option is not set.
+func (o SourceOptions) Duration(key string, defaultValue time.Duration) (time.Duration, error) {
+	value, ok := o[key]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+
+	result, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("option %q: %w", key, err)
+	}
+
+	return result, nil
+}
+
+// List returns comma separated option value as a slice.
+func (o SourceOptions) List(key string) []string {
+	var result []string
+
+	for item := range strings.SplitSeq(o[key], ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// Registry is a registry of source factories.
+type Registry struct {
+	factories map[string]SourceFactory
+}
+
+// NewRegistry returns new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]SourceFactory),
+	}
+}
+
+// Register factory for the source kind, factory registered later replaces previous one.
+func (r *Registry) Register(kind string, factory SourceFactory) {
+	r.factories[kind] = factory
+}
+
+// build constructs enabled sources only.
+func (r *Registry) build(configs []SourceConfig) ([]collectInstance, error) {
+	instances := make([]collectInstance, 0, len(configs))
+	seen := make(map[string]struct{}, len(configs))
+
+	for _, cfg := range configs {
+		if _, ok := seen[cfg.Name]; ok {
+			return nil, fmt.Errorf("%w: %s", errDuplicateSource, cfg.Name)
+		}
+		seen[cfg.Name] = struct{}{}
+
+		kind := cfg.Kind
+		if kind == "" {
+			kind = cfg.Name
+		}
+
+		factory, ok := r.factories[kind]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errUnknownSourceKind, kind)
+		}
+
+		multiplayer := domain.Multiplayer(cfg.Name)
+
+		source, err := factory(multiplayer, cfg.Options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s source: %w", cfg.Name, err)
+		}
+
+		interval := cfg.Interval
+		if interval <= 0 {
+			interval = defaultSourceInterval
+		}
+
+		if interval < minSourceInterval {
+			return nil, fmt.Errorf("%w: %s must be collected not more often than once per %s", errIntervalTooShort, cfg.Name, minSourceInterval)
+		}
+
+		schedule := worker.Every(interval)
+		if cfg.Schedule != "" {
+			schedule, err = worker.ParseCron(cfg.Schedule)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s schedule: %w", cfg.Name, err)
+			}
+		}
+
+		maxRuntime := cfg.MaxRuntime
+		if maxRuntime <= 0 {
+			maxRuntime = defaultSourceMaxRuntime
+		}
+
+		instances = append(instances, collectInstance{
+			Multiplayer: multiplayer,
+			Schedule:    schedule,
+			Jitter:      cfg.Jitter,
+			RunOnStart:  cfg.RunOnStart,
+			MaxRuntime:  maxRuntime,
+			Collect:     source.Servers,
+		})
+	}
+
+	return instances, nil
+}
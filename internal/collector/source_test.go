@@ -0,0 +1,155 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package collector
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+)
+
+type fakeSource struct {
+	multiplayer domain.Multiplayer
+	opts        SourceOptions
+}
+
+func (s *fakeSource) Servers(_ context.Context, collectedAt time.Time) ([]domain.Server, error) {
+	return []domain.Server{
+		{
+			Multiplayer: s.multiplayer,
+			Host:        s.opts.String("host", "127.0.0.1:1"),
+			CollectedAt: collectedAt,
+		},
+	}, nil
+}
+
+func newFakeRegistry(created map[string]int) *Registry {
+	registry := NewRegistry()
+
+	for _, kind := range []string{"first", "second", "third"} {
+		registry.Register(kind, func(multiplayer domain.Multiplayer, opts SourceOptions) (Source, error) {
+			created[kind]++
+
+			if opts.String("fail", "") != "" {
+				return nil, errors.New("failed")
+			}
+
+			return &fakeSource{multiplayer: multiplayer, opts: opts}, nil
+		})
+	}
+
+	return registry
+}
+
+func TestRegistry_build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OnlyEnabled", func(t *testing.T) {
+		t.Parallel()
+
+		created := make(map[string]int)
+		registry := newFakeRegistry(created)
+
+		instances, err := registry.build([]SourceConfig{
+			{
+				Name:     "first",
+				Interval: 5 * time.Minute,
+			},
+			{
+				Name:    "alias",
+				Kind:    "third",
+				Options: SourceOptions{"host": "10.0.0.1:22003"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, instances, 2)
+
+		assert.Equal(t, map[string]int{"first": 1, "third": 1}, created)
+
+		assert.Equal(t, domain.Multiplayer("first"), instances[0].Multiplayer)
+		now := time.Now()
+		assert.Equal(t, now.Truncate(5*time.Minute), instances[0].Schedule.Prev(now))
+
+		assert.Equal(t, domain.Multiplayer("alias"), instances[1].Multiplayer)
+		assert.Equal(t, now.Truncate(defaultSourceInterval), instances[1].Schedule.Prev(now))
+
+		servers, err := instances[1].Collect(t.Context(), time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Server{{Multiplayer: "alias", Host: "10.0.0.1:22003"}}, servers)
+	})
+
+	t.Run("UnknownKind", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newFakeRegistry(make(map[string]int)).build([]SourceConfig{{Name: "unknown"}})
+		assert.ErrorIs(t, err, errUnknownSourceKind)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newFakeRegistry(make(map[string]int)).build([]SourceConfig{{Name: "first"}, {Name: "first"}})
+		assert.ErrorIs(t, err, errDuplicateSource)
+	})
+
+	t.Run("IntervalTooShort", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newFakeRegistry(make(map[string]int)).build([]SourceConfig{{Name: "first", Interval: time.Second}})
+		assert.ErrorIs(t, err, errIntervalTooShort)
+	})
+
+	t.Run("BadSchedule", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newFakeRegistry(make(map[string]int)).build([]SourceConfig{{Name: "first", Schedule: "bad"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("FactoryError", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newFakeRegistry(make(map[string]int)).build([]SourceConfig{{Name: "second", Options: SourceOptions{"fail": "1"}}})
+		assert.Error(t, err)
+	})
+}
+
+func TestSourceOptions(t *testing.T) {
+	t.Parallel()
+
+	opts := SourceOptions{
+		"concurrency":   "16",
+		"probe":         "true",
+		"query_timeout": "2s",
+		"targets":       "1.2.3.4:27015, ,1.2.3.5:27015",
+		"bad":           "bad",
+	}
+
+	concurrency, err := opts.Int("concurrency", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 16, concurrency)
+
+	probe, err := opts.Bool("probe", false)
+	require.NoError(t, err)
+	assert.True(t, probe)
+
+	queryTimeout, err := opts.Duration("query_timeout", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, queryTimeout)
+
+	missing, err := opts.Duration("missing", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, missing)
+
+	assert.Equal(t, []string{"1.2.3.4:27015", "1.2.3.5:27015"}, opts.List("targets"))
+
+	_, err = opts.Int("bad", 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package sources
+
+import (
+	"errors"
+	"net/http"
+
+	a2sAdapter "github.com/EpicStep/gdatum/internal/adapters/a2s"
+	altvAdapter "github.com/EpicStep/gdatum/internal/adapters/altv"
+	cfxAdapter "github.com/EpicStep/gdatum/internal/adapters/cfx"
+	mtaAdapter "github.com/EpicStep/gdatum/internal/adapters/mta"
+	ragempAdapter "github.com/EpicStep/gdatum/internal/adapters/ragemp"
+	sampAdapter "github.com/EpicStep/gdatum/internal/adapters/samp"
+	"github.com/EpicStep/gdatum/internal/collector"
+	"github.com/EpicStep/gdatum/internal/domain"
+	a2sClient "github.com/EpicStep/gdatum/internal/infrastructure/clients/a2s"
+	altvClient "github.com/EpicStep/gdatum/internal/infrastructure/clients/altv"
+	cfxClient "github.com/EpicStep/gdatum/internal/infrastructure/clients/cfx"
+	mtaClient "github.com/EpicStep/gdatum/internal/infrastructure/clients/mta"
+	ragempClient "github.com/EpicStep/gdatum/internal/infrastructure/clients/ragemp"
+	sampClient "github.com/EpicStep/gdatum/internal/infrastructure/clients/samp"
+)
+
+// Source kinds.
+const (
+	KindRagemp = "ragemp"
+	KindAltv   = "altv"
+	KindFivem  = "fivem"
+	KindRedm   = "redm"
+	KindSamp   = "samp"
+	KindMta    = "mta"
+	KindA2S    = "a2s"
+)
+
+const (
+	concurrencyOption      = "concurrency"
+	queryTimeoutOption     = "query_timeout"
+	probeOption            = "probe"
+	maxFailedPercentOption = "max_failed_percent"
+	targetsOption          = "targets"
+)
+
+var errNoTargets = errors.New("targets option is required")
+
+// Register registers factories of all built-in sources, httpClient is used by every platform client to egress.
+func Register(registry *collector.Registry, httpClient *http.Client) {
+	f := factories{
+		httpClient: httpClient,
+		// FiveM and RedM share one Cfx.re server list.
+		cfx: cfxClient.New(cfxClient.NewOpts{HTTPClient: httpClient}),
+	}
+
+	registry.Register(KindRagemp, f.newRagemp)
+	registry.Register(KindAltv, f.newAltv)
+	registry.Register(KindFivem, f.newCfx(cfxClient.GameFivem))
+	registry.Register(KindRedm, f.newCfx(cfxClient.GameRedm))
+	registry.Register(KindSamp, f.newSamp)
+	registry.Register(KindMta, f.newMta)
+	registry.Register(KindA2S, f.newA2S)
+}
+
+type factories struct {
+	httpClient *http.Client
+	cfx        *cfxClient.Client
+}
+
+func (f factories) newRagemp(multiplayer domain.Multiplayer, _ collector.SourceOptions) (collector.Source, error) {
+	return ragempAdapter.New(ragempClient.New(ragempClient.NewOpts{HTTPClient: f.httpClient}), multiplayer), nil
+}
+
+func (f factories) newAltv(multiplayer domain.Multiplayer, _ collector.SourceOptions) (collector.Source, error) {
+	return altvAdapter.New(altvClient.New(altvClient.NewOpts{HTTPClient: f.httpClient}), multiplayer), nil
+}
+
+func (f factories) newCfx(game string) collector.SourceFactory {
+	return func(multiplayer domain.Multiplayer, _ collector.SourceOptions) (collector.Source, error) {
+		return cfxAdapter.New(f.cfx, multiplayer, game), nil
+	}
+}
+
+func (f factories) newSamp(multiplayer domain.Multiplayer, opts collector.SourceOptions) (collector.Source, error) {
+	concurrency, err := opts.Int(concurrencyOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	queryTimeout, err := opts.Duration(queryTimeoutOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFailedPercent, err := opts.Int(maxFailedPercentOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return sampAdapter.New(sampClient.New(sampClient.NewOpts{HTTPClient: f.httpClient}), multiplayer, sampAdapter.NewOpts{
+		Concurrency:      concurrency,
+		QueryTimeout:     queryTimeout,
+		MaxFailedPercent: maxFailedPercent,
+	}), nil
+}
+
+func (f factories) newMta(multiplayer domain.Multiplayer, opts collector.SourceOptions) (collector.Source, error) {
+	probe, err := opts.Bool(probeOption, false)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency, err := opts.Int(concurrencyOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	queryTimeout, err := opts.Duration(queryTimeoutOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFailedPercent, err := opts.Int(maxFailedPercentOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return mtaAdapter.New(mtaClient.New(mtaClient.NewOpts{HTTPClient: f.httpClient}), multiplayer, mtaAdapter.NewOpts{
+		Probe:            probe,
+		Concurrency:      concurrency,
+		QueryTimeout:     queryTimeout,
+		MaxFailedPercent: maxFailedPercent,
+	}), nil
+}
+
+func (factories) newA2S(multiplayer domain.Multiplayer, opts collector.SourceOptions) (collector.Source, error) {
+	targets := opts.List(targetsOption)
+	if len(targets) == 0 {
+		return nil, errNoTargets
+	}
+
+	concurrency, err := opts.Int(concurrencyOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	queryTimeout, err := opts.Duration(queryTimeoutOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFailedPercent, err := opts.Int(maxFailedPercentOption, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return a2sAdapter.New(a2sClient.New(a2sClient.NewOpts{}), multiplayer, a2sAdapter.NewOpts{
+		Targets:          targets,
+		Concurrency:      concurrency,
+		QueryTimeout:     queryTimeout,
+		MaxFailedPercent: maxFailedPercent,
+	}), nil
+}
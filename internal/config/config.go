@@ -4,26 +4,98 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"go.uber.org/zap/zapcore"
 )
 
+const (
+	// defaultSources are sources collected before sources became configurable, other ones are opt-in.
+	defaultSources  = "ragemp,altv"
+	defaultInterval = "1h"
+
+	sourceEnvPrefix = "COLLECTOR_SOURCE_"
+
+	sourceIntervalKey   = "interval"
+	sourceScheduleKey   = "schedule"
+	sourceJitterKey     = "jitter"
+	sourceRunOnStartKey = "run_on_start"
+	sourceMaxRuntimeKey = "max_runtime"
+)
+
+// Database drivers.
+const (
+	DatabaseDriverClickHouse  = "clickhouse"
+	DatabaseDriverPostgres    = "postgres"
+	DatabaseDriverTimescaleDB = "timescaledb"
+	DatabaseDriverSQLite      = "sqlite"
+)
+
+// Lease drivers.
+const (
+	LeaseDriverNone       = "none"
+	LeaseDriverFile       = "file"
+	LeaseDriverClickHouse = "clickhouse"
+)
+
 // Config of the application.
 type Config struct {
-	DatabaseDSN string `json:"-"`
+	// DatabaseDriver is a storage backend, one of "clickhouse", "postgres", "timescaledb" or "sqlite".
+	// DatabaseDSN of SQLite is a path of the database file.
+	DatabaseDriver string
+	DatabaseDSN    string `json:"-"`
 
 	PublicListenAddress string
 	AdminListenAddress  string
+
+	// Sources are enabled collector sources, loaded from COLLECTOR_SOURCES, default is "ragemp,altv".
+	// Built-in kinds are "ragemp", "altv", "fivem", "redm", "samp", "mta" and "a2s",
+	// e.g. "ragemp,altv,fivem,redm,samp,mta,gmod:a2s" enables all of them.
+	Sources []Source
+	// CollectorInterval is a collection interval of sources, that have no own interval.
+	CollectorInterval time.Duration
+
+	// LeaseDriver is a lease, that lets only one replica collect every tick, one of "none", "file" or "clickhouse".
+	LeaseDriver string
+	// LeaseFileDir is a directory of "file" lease claims, it must be shared by replicas.
+	LeaseFileDir string
+
+	// EgressTimeout is a timeout of requests to the upstreams.
+	EgressTimeout time.Duration
+	// EgressProxyURL is a proxy for requests to the upstreams, it may contain credentials.
+	EgressProxyURL string `json:"-"`
+	// EgressMaxBodySize is a maximum size of upstream response body in bytes.
+	EgressMaxBodySize int64
+}
+
+// Source is a config of collector source.
+type Source struct {
+	Name     string
+	Kind     string
+	Interval time.Duration
+	// Schedule is an optional cron expression, that overrides Interval based schedule.
+	Schedule   string
+	Jitter     time.Duration
+	RunOnStart bool
+	MaxRuntime time.Duration
+	Options    map[string]string
 }
 
 func (c *Config) validate() error {
 	return validation.ValidateStruct(c,
+		validation.Field(&c.DatabaseDriver, validation.In(DatabaseDriverClickHouse, DatabaseDriverPostgres, DatabaseDriverTimescaleDB, DatabaseDriverSQLite)),
 		validation.Field(&c.DatabaseDSN, validation.Required),
 		validation.Field(&c.PublicListenAddress, validation.Required),
 		validation.Field(&c.AdminListenAddress, validation.Required),
+		validation.Field(&c.CollectorInterval, validation.Min(time.Minute)),
+		validation.Field(&c.LeaseDriver, validation.In(LeaseDriverNone, LeaseDriverFile, LeaseDriverClickHouse)),
 	)
 }
 
@@ -35,12 +107,44 @@ func (c *Config) MarshalLogObject(e zapcore.ObjectEncoder) error {
 // Load Config from env.
 func Load() (*Config, error) {
 	cfg := &Config{
+		DatabaseDriver:      loadValue("DATABASE_DRIVER", DatabaseDriverClickHouse),
 		DatabaseDSN:         loadValue("DATABASE_DSN", ""),
 		PublicListenAddress: loadValue("PUBLIC_LISTEN_ADDRESS", "127.0.0.1:8080"),
 		AdminListenAddress:  loadValue("ADMIN_LISTEN_ADDRESS", "127.0.0.1:8081"),
+		LeaseDriver:         loadValue("LEASE_DRIVER", LeaseDriverNone),
+		LeaseFileDir:        loadValue("LEASE_FILE_DIR", filepath.Join(os.TempDir(), "gdatum-leases")),
+		EgressProxyURL:      loadValue("EGRESS_PROXY_URL", ""),
+	}
+
+	sources, err := loadSources(loadValue("COLLECTOR_SOURCES", defaultSources), os.Environ())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sources: %w", err)
 	}
 
-	if err := cfg.validate(); err != nil {
+	cfg.Sources = sources
+
+	cfg.CollectorInterval, err = time.ParseDuration(loadValue("COLLECTOR_INTERVAL", defaultInterval))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse COLLECTOR_INTERVAL: %w", err)
+	}
+
+	for i := range cfg.Sources {
+		if cfg.Sources[i].Interval == 0 {
+			cfg.Sources[i].Interval = cfg.CollectorInterval
+		}
+	}
+
+	cfg.EgressTimeout, err = time.ParseDuration(loadValue("EGRESS_TIMEOUT", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EGRESS_TIMEOUT: %w", err)
+	}
+
+	cfg.EgressMaxBodySize, err = strconv.ParseInt(loadValue("EGRESS_MAX_BODY_SIZE", "67108864"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EGRESS_MAX_BODY_SIZE: %w", err)
+	}
+
+	if err = cfg.validate(); err != nil {
 		return nil, fmt.Errorf("failed to validate config: %w", err)
 	}
 
@@ -55,3 +159,119 @@ func loadValue(key, defaultValue string) string {
 
 	return value
 }
+
+var errBadSource = errors.New("source must be in 'name' or 'name:kind' format")
+
+// loadSources parses comma separated list of sources in "name" or "name:kind" format, for example "ragemp,gmod:a2s".
+// Interval and options of the source are loaded from COLLECTOR_SOURCE_<NAME>_<OPTION> variables of environ.
+func loadSources(value string, environ []string) ([]Source, error) {
+	var sources []Source
+
+	for item := range strings.SplitSeq(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, kind, _ := strings.Cut(item, ":")
+		if name == "" {
+			return nil, errBadSource
+		}
+
+		sources = append(sources, Source{
+			Name:    name,
+			Kind:    kind,
+			Options: make(map[string]string),
+		})
+	}
+
+	for _, env := range environ {
+		key, value, ok := strings.Cut(env, "=")
+		if !ok || !strings.HasPrefix(key, sourceEnvPrefix) {
+			continue
+		}
+
+		// the longest prefix wins, so options of "gmod-darkrp" are not treated as options of "gmod".
+		var (
+			source *Source
+			prefix string
+		)
+
+		for i := range sources {
+			sourcePrefix := sourceEnvPrefix + envName(sources[i].Name) + "_"
+			if strings.HasPrefix(key, sourcePrefix) && len(sourcePrefix) > len(prefix) {
+				source, prefix = &sources[i], sourcePrefix
+			}
+		}
+
+		if source != nil {
+			source.Options[strings.ToLower(strings.TrimPrefix(key, prefix))] = value
+		}
+	}
+
+	for i := range sources {
+		if err := loadSourceSchedule(&sources[i]); err != nil {
+			return nil, fmt.Errorf("failed to load %s schedule: %w", sources[i].Name, err)
+		}
+	}
+
+	return sources, nil
+}
+
+// loadSourceSchedule moves schedule options of the source to its fields.
+func loadSourceSchedule(source *Source) error {
+	var err error
+
+	if value, ok := popOption(source.Options, sourceIntervalKey); ok {
+		if source.Interval, err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("failed to parse interval: %w", err)
+		}
+	}
+
+	if value, ok := popOption(source.Options, sourceScheduleKey); ok {
+		source.Schedule = value
+	}
+
+	if value, ok := popOption(source.Options, sourceJitterKey); ok {
+		if source.Jitter, err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("failed to parse jitter: %w", err)
+		}
+	}
+
+	if value, ok := popOption(source.Options, sourceRunOnStartKey); ok {
+		if source.RunOnStart, err = strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("failed to parse run on start: %w", err)
+		}
+	}
+
+	if value, ok := popOption(source.Options, sourceMaxRuntimeKey); ok {
+		if source.MaxRuntime, err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("failed to parse max runtime: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func popOption(options map[string]string, key string) (string, bool) {
+	value, ok := options[key]
+	if ok {
+		delete(options, key)
+	}
+
+	return value, ok
+}
+
+// envName converts name to the environment variable name part.
+func envName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'):
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
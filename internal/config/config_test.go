@@ -0,0 +1,95 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSources(t *testing.T) {
+	t.Parallel()
+
+	environ := []string{
+		"COLLECTOR_SOURCE_RAGEMP_INTERVAL=15m",
+		"COLLECTOR_SOURCE_RAGEMP_SCHEDULE=*/15 * * * *",
+		"COLLECTOR_SOURCE_RAGEMP_JITTER=30s",
+		"COLLECTOR_SOURCE_RAGEMP_RUN_ON_START=true",
+		"COLLECTOR_SOURCE_RAGEMP_MAX_RUNTIME=10m",
+		"COLLECTOR_SOURCE_GMOD_TARGETS=1.2.3.4:27015,1.2.3.5:27016",
+		"COLLECTOR_SOURCE_GMOD_QUERY_TIMEOUT=2s",
+		"COLLECTOR_SOURCE_GMOD_DARKRP_TARGETS=1.2.3.6:27015",
+		"COLLECTOR_SOURCE_MTA_INTERVAL=bad",
+		"PATH=/usr/bin",
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		want    []Source
+		wantErr bool
+	}{
+		{
+			name:  "Empty",
+			value: "",
+			want:  nil,
+		},
+		{
+			name:  "Valid",
+			value: "ragemp, gmod:a2s, gmod-darkrp:a2s",
+			want: []Source{
+				{
+					Name:       "ragemp",
+					Interval:   15 * time.Minute,
+					Schedule:   "*/15 * * * *",
+					Jitter:     30 * time.Second,
+					RunOnStart: true,
+					MaxRuntime: 10 * time.Minute,
+					Options:    map[string]string{},
+				},
+				{
+					Name: "gmod",
+					Kind: "a2s",
+					Options: map[string]string{
+						"targets":       "1.2.3.4:27015,1.2.3.5:27016",
+						"query_timeout": "2s",
+					},
+				},
+				{
+					Name: "gmod-darkrp",
+					Kind: "a2s",
+					Options: map[string]string{
+						"targets": "1.2.3.6:27015",
+					},
+				},
+			},
+		},
+		{
+			name:    "InvalidWithoutName",
+			value:   ":a2s",
+			wantErr: true,
+		},
+		{
+			name:    "InvalidInterval",
+			value:   "mta",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := loadSources(tt.value, environ)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
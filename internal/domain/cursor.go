@@ -0,0 +1,51 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrBadCursor ...
+var ErrBadCursor = errors.New("cursor is malformed")
+
+var errCursorParamsMismatch = fmt.Errorf("%w: cursor belongs to the query with other params", ErrBadCursor)
+
+// ServerSummaryCursor is a keyset position of the last server of the page.
+// Query params, that change order of the pages, are kept to reject cursor reused with other ones.
+type ServerSummaryCursor struct {
+	PlayersCount    int32  `json:"p"`
+	Host            string `json:"h"`
+	PlayersOrderAsc bool   `json:"a,omitempty"`
+	IncludeOffline  bool   `json:"o,omitempty"`
+}
+
+// String returns opaque representation of the cursor.
+func (c ServerSummaryCursor) String() string {
+	raw, _ := json.Marshal(c) //nolint:errchkjson
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// ParseServerSummaryCursor parses cursor returned by ServerSummaryCursor.String.
+func ParseServerSummaryCursor(value string) (ServerSummaryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return ServerSummaryCursor{}, fmt.Errorf("%w: %w", ErrBadCursor, err)
+	}
+
+	var cursor ServerSummaryCursor
+	if err = json.Unmarshal(raw, &cursor); err != nil {
+		return ServerSummaryCursor{}, fmt.Errorf("%w: %w", ErrBadCursor, err)
+	}
+
+	if cursor.Host == "" {
+		return ServerSummaryCursor{}, ErrBadCursor
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerSummaryCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		t.Parallel()
+
+		cursor := ServerSummaryCursor{PlayersCount: 42, Host: "127.0.0.1:22005", PlayersOrderAsc: true, IncludeOffline: true}
+
+		parsed, err := ParseServerSummaryCursor(cursor.String())
+		require.NoError(t, err)
+		assert.Equal(t, cursor, parsed)
+	})
+
+	for name, value := range map[string]string{
+		"NotBase64": "!",
+		"NotJSON":   "bm90IGpzb24",
+		"NoHost":    ServerSummaryCursor{PlayersCount: 1}.String(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseServerSummaryCursor(value)
+			assert.ErrorIs(t, err, ErrBadCursor)
+		})
+	}
+}
@@ -8,4 +8,8 @@ import "errors"
 var (
 	// ErrServerNotFound ...
 	ErrServerNotFound = errors.New("server not found")
+	// ErrMultiplayerNotFound ...
+	ErrMultiplayerNotFound = errors.New("multiplayer not found")
+	// ErrInvalidParams is wrapped by validation errors of query params.
+	ErrInvalidParams = errors.New("invalid params")
 )
@@ -4,6 +4,7 @@
 package domain
 
 import (
+	"slices"
 	"time"
 )
 
@@ -15,6 +16,14 @@ const (
 	MultiplayerRagemp = "ragemp"
 	// MultiplayerAltv ...
 	MultiplayerAltv = "altv"
+	// MultiplayerFivem ...
+	MultiplayerFivem = "fivem"
+	// MultiplayerRedm ...
+	MultiplayerRedm = "redm"
+	// MultiplayerSamp ...
+	MultiplayerSamp = "samp"
+	// MultiplayerMta ...
+	MultiplayerMta = "mta"
 )
 
 // MultiplayerSummary ...
@@ -23,9 +32,80 @@ type MultiplayerSummary struct {
 	PlayersCount int64
 }
 
+// MultiplayerStatisticPoint represents a single data point of the multiplayer graph.
+// Counts are averages of snapshots collected in the bucket.
+type MultiplayerStatisticPoint struct {
+	PlayersCount int64
+	ServersCount int64
+	CollectedAt  time.Time
+}
+
+// ServerSummariesPage ...
+type ServerSummariesPage struct {
+	Servers []ServerSummary
+	// NextCursor is nil, when page is the last one.
+	NextCursor *ServerSummaryCursor
+	// Total is a count of all servers of the multiplayer.
+	Total uint64
+}
+
+// FacetValue is a count of servers with the value.
+type FacetValue struct {
+	Value string
+	Count uint64
+}
+
+// ServerFacets are counts of all found servers, regardless of pagination.
+type ServerFacets struct {
+	Languages []FacetValue
+	Gamemodes []FacetValue
+}
+
+// ServerSearchResult ...
+type ServerSearchResult struct {
+	Servers []ServerSummary
+	// Total is a count of all found servers.
+	Total  uint64
+	Facets ServerFacets
+}
+
+// TrendingServer is a server with change of average players between the window and the previous one.
+// RelativeChange is nil, when server had no players in the previous window.
+type TrendingServer struct {
+	Host           string
+	Name           string
+	PlayersBefore  float64
+	PlayersAfter   float64
+	PlayersChange  float64
+	RelativeChange *float64
+}
+
+// ServerStatisticStatus is a status of the server in the statistics bucket.
+type ServerStatisticStatus string
+
+const (
+	// ServerStatisticStatusOnline means, that server was collected in the bucket.
+	ServerStatisticStatusOnline ServerStatisticStatus = "online"
+	// ServerStatisticStatusOffline means, that multiplayer was collected in the bucket, but server was missing.
+	ServerStatisticStatusOffline ServerStatisticStatus = "offline"
+	// ServerStatisticStatusCollectionFailed means, that all collections of the multiplayer in the bucket failed.
+	ServerStatisticStatusCollectionFailed ServerStatisticStatus = "collectionFailed"
+	// ServerStatisticStatusUnknown means, that there is no collection run in the bucket,
+	// e.g. collector was not running or bucket is older than collection runs log.
+	ServerStatisticStatusUnknown ServerStatisticStatus = "unknown"
+)
+
 // ServerStatisticPoint represents a single data point in a graph.
+// PlayersCount is an average, other aggregates are set only if they were requested.
+// Players are not set, when status is not online.
 type ServerStatisticPoint struct {
+	Status       ServerStatisticStatus
 	PlayersCount int32
+	PlayersMin   int32
+	PlayersMax   int32
+	PlayersP50   int32
+	PlayersP95   int32
+	Samples      uint64
 	CollectedAt  time.Time
 }
 
@@ -38,7 +118,116 @@ type Server struct {
 	Gamemode     string
 	Language     string
 	PlayersCount int32
+	MaxPlayers   int32
+	// PeakPlayers is a players record of the server, that platform reports, zero when platform doesn't report it.
+	PeakPlayers int32
+	// Map is a name of the current map, empty when platform doesn't report it.
+	Map     string
+	Version string
+	// Passworded is nil, when platform doesn't report it.
+	Passworded  *bool
+	Tags        []string
+	CollectedAt time.Time
+}
+
+// ServerField is a server metadata field, changes of which are tracked.
+type ServerField string
+
+const (
+	// ServerFieldName ...
+	ServerFieldName ServerField = "name"
+	// ServerFieldURL ...
+	ServerFieldURL ServerField = "url"
+	// ServerFieldGamemode ...
+	ServerFieldGamemode ServerField = "gamemode"
+	// ServerFieldLanguage ...
+	ServerFieldLanguage ServerField = "language"
+)
+
+// ServerChange is a change of the server metadata field.
+type ServerChange struct {
+	Multiplayer Multiplayer
+	Host        string
+	Field       ServerField
+	OldValue    string
+	NewValue    string
+	ChangedAt   time.Time
+}
+
+// Changes returns changes of the tracked metadata fields from previous server state to s.
+func (s Server) Changes(previous Server) []ServerChange {
+	fields := []struct {
+		field              ServerField
+		oldValue, newValue string
+	}{
+		{ServerFieldName, previous.Name, s.Name},
+		{ServerFieldURL, previous.URL, s.URL},
+		{ServerFieldGamemode, previous.Gamemode, s.Gamemode},
+		{ServerFieldLanguage, previous.Language, s.Language},
+	}
+
+	var changes []ServerChange
+
+	for _, f := range fields {
+		if f.oldValue == f.newValue {
+			continue
+		}
+
+		changes = append(changes, ServerChange{
+			Multiplayer: s.Multiplayer,
+			Host:        s.Host,
+			Field:       f.field,
+			OldValue:    f.oldValue,
+			NewValue:    f.newValue,
+			ChangedAt:   s.CollectedAt,
+		})
+	}
+
+	return changes
+}
+
+// CollectionRunStatus ...
+type CollectionRunStatus string
+
+const (
+	// CollectionRunStatusOK ...
+	CollectionRunStatusOK CollectionRunStatus = "ok"
+	// CollectionRunStatusFailed ...
+	CollectionRunStatusFailed CollectionRunStatus = "failed"
+)
+
+// CollectionRun is a single collection of the multiplayer servers.
+type CollectionRun struct {
+	Multiplayer  Multiplayer
 	CollectedAt  time.Time
+	Status       CollectionRunStatus
+	ServersCount int32
+	Error        string
+}
+
+// Snapshot is a servers of the multiplayer collected at the same time.
+type Snapshot struct {
+	// ID is the same for every collection of the multiplayer at the same time,
+	// so snapshot inserted twice, by retry or by another replica, is stored once.
+	ID          string
+	Multiplayer Multiplayer
+	CollectedAt time.Time
+	Servers     []Server
+}
+
+// NewSnapshot returns Snapshot with deterministic ID.
+func NewSnapshot(multiplayer Multiplayer, collectedAt time.Time, servers []Server) Snapshot {
+	return Snapshot{
+		ID:          SnapshotID(multiplayer, collectedAt),
+		Multiplayer: multiplayer,
+		CollectedAt: collectedAt,
+		Servers:     servers,
+	}
+}
+
+// SnapshotID returns ID of the snapshot of the multiplayer collected at collectedAt.
+func SnapshotID(multiplayer Multiplayer, collectedAt time.Time) string {
+	return string(multiplayer) + "@" + collectedAt.UTC().Format(time.RFC3339)
 }
 
 // ServerSummary ...
@@ -46,4 +235,102 @@ type ServerSummary struct {
 	Host         string
 	Name         string
 	PlayersCount int32
+	// Online reports whether server is present in the latest snapshot of the multiplayer.
+	Online bool
+	// LastSeenAt is a time of the latest snapshot, that server was present in.
+	LastSeenAt time.Time
+}
+
+// ServerUptimeMaxWindow is the longest window of ServerUptime, collection runs older than it are not used.
+const ServerUptimeMaxWindow = 30 * 24 * time.Hour
+
+// ServerRunPresence is a successful collection run of the multiplayer and whether the server was present in it.
+type ServerRunPresence struct {
+	CollectedAt time.Time
+	Present     bool
+}
+
+// ServerUptime is an availability of the server by successful collection runs of its multiplayer,
+// so failed collections, e.g. platform-wide outages, do not count against the server.
+type ServerUptime struct {
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+	// Availability24h, Availability7d and Availability30d are percentages of successful collection runs of the window,
+	// that server was present in. Runs before server was first seen are not counted,
+	// availability is nil, when there were no such runs in the window.
+	Availability24h *float64
+	Availability7d  *float64
+	Availability30d *float64
+	// LongestOutage is the longest time in ServerUptimeMaxWindow, that server was missing from successful collection runs.
+	// Outage lasts from the first missed run until the run, that server was present in again, or until now, if it is ongoing.
+	LongestOutage time.Duration
+	// LongestOutageStartedAt is zero, when there were no outages.
+	LongestOutageStartedAt time.Time
+}
+
+// NewServerUptime returns ServerUptime by successful collection runs ordered by time.
+func NewServerUptime(now, firstSeenAt, lastSeenAt time.Time, runs []ServerRunPresence) ServerUptime {
+	uptime := ServerUptime{
+		FirstSeenAt: firstSeenAt,
+		LastSeenAt:  lastSeenAt,
+	}
+
+	// server did not exist before it was first seen, so these runs are not outages.
+	runs = slices.DeleteFunc(slices.Clone(runs), func(run ServerRunPresence) bool {
+		return run.CollectedAt.Before(firstSeenAt) || run.CollectedAt.Before(now.Add(-ServerUptimeMaxWindow))
+	})
+
+	uptime.Availability24h = availability(runs, now.Add(-24*time.Hour))
+	uptime.Availability7d = availability(runs, now.Add(-7*24*time.Hour))
+	uptime.Availability30d = availability(runs, now.Add(-ServerUptimeMaxWindow))
+
+	var outageStartedAt time.Time
+
+	recordOutage := func(endedAt time.Time) {
+		if outage := endedAt.Sub(outageStartedAt); outage > uptime.LongestOutage {
+			uptime.LongestOutage = outage
+			uptime.LongestOutageStartedAt = outageStartedAt
+		}
+	}
+
+	for _, run := range runs {
+		switch {
+		case !run.Present && outageStartedAt.IsZero():
+			outageStartedAt = run.CollectedAt
+		case run.Present && !outageStartedAt.IsZero():
+			recordOutage(run.CollectedAt)
+			outageStartedAt = time.Time{}
+		}
+	}
+
+	if !outageStartedAt.IsZero() {
+		recordOutage(now)
+	}
+
+	return uptime
+}
+
+// availability returns percentage of runs since from, that server was present in.
+func availability(runs []ServerRunPresence, from time.Time) *float64 {
+	var total, present int
+
+	for _, run := range runs {
+		if run.CollectedAt.Before(from) {
+			continue
+		}
+
+		total++
+
+		if run.Present {
+			present++
+		}
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	percentage := float64(present) / float64(total) * 100
+
+	return &percentage
 }
@@ -0,0 +1,161 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSnapshot(t *testing.T) {
+	t.Parallel()
+
+	collectedAt := time.Date(2025, 3, 10, 12, 15, 0, 0, time.UTC)
+
+	first := NewSnapshot(MultiplayerRagemp, collectedAt, nil)
+	second := NewSnapshot(MultiplayerRagemp, collectedAt.In(time.FixedZone("UTC+3", 3*60*60)), []Server{{Host: "127.0.0.1"}})
+
+	assert.Equal(t, "ragemp@2025-03-10T12:15:00Z", first.ID)
+	assert.Equal(t, first.ID, second.ID, "same collection must have same ID")
+	assert.NotEqual(t, first.ID, NewSnapshot(MultiplayerAltv, collectedAt, nil).ID)
+	assert.NotEqual(t, first.ID, NewSnapshot(MultiplayerRagemp, collectedAt.Add(15*time.Minute), nil).ID)
+}
+
+func TestServer_Changes(t *testing.T) {
+	t.Parallel()
+
+	collectedAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	previous := Server{
+		Multiplayer: MultiplayerRagemp,
+		Host:        "127.0.0.1:22005",
+		Name:        "Old name",
+		URL:         "old.gg",
+		Gamemode:    "freeroam",
+		Language:    "en",
+	}
+
+	current := previous
+	current.Name = "New name"
+	current.Gamemode = "roleplay"
+	current.PlayersCount = 100
+	current.CollectedAt = collectedAt
+
+	assert.Equal(t, []ServerChange{
+		{
+			Multiplayer: MultiplayerRagemp,
+			Host:        "127.0.0.1:22005",
+			Field:       ServerFieldName,
+			OldValue:    "Old name",
+			NewValue:    "New name",
+			ChangedAt:   collectedAt,
+		},
+		{
+			Multiplayer: MultiplayerRagemp,
+			Host:        "127.0.0.1:22005",
+			Field:       ServerFieldGamemode,
+			OldValue:    "freeroam",
+			NewValue:    "roleplay",
+			ChangedAt:   collectedAt,
+		},
+	}, current.Changes(previous))
+
+	assert.Empty(t, previous.Changes(previous))
+}
+
+func TestNewServerUptime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	run := func(ago time.Duration, present bool) ServerRunPresence {
+		return ServerRunPresence{CollectedAt: now.Add(-ago), Present: present}
+	}
+
+	tests := []struct {
+		name        string
+		firstSeenAt time.Time
+		runs        []ServerRunPresence
+		expected    ServerUptime
+	}{
+		{
+			name:        "NoRuns",
+			firstSeenAt: now.Add(-time.Hour),
+		},
+		{
+			name:        "AlwaysPresent",
+			firstSeenAt: now.Add(-48 * time.Hour),
+			runs:        []ServerRunPresence{run(48*time.Hour, true), run(2*time.Hour, true), run(time.Hour, true)},
+			expected: ServerUptime{
+				Availability24h: lo.ToPtr(100.0),
+				Availability7d:  lo.ToPtr(100.0),
+				Availability30d: lo.ToPtr(100.0),
+			},
+		},
+		{
+			name:        "CompletedOutage",
+			firstSeenAt: now.Add(-10 * time.Hour),
+			runs: []ServerRunPresence{
+				run(10*time.Hour, true), run(9*time.Hour, false), run(8*time.Hour, false), run(7*time.Hour, true), run(6*time.Hour, true),
+			},
+			expected: ServerUptime{
+				Availability24h:        lo.ToPtr(60.0),
+				Availability7d:         lo.ToPtr(60.0),
+				Availability30d:        lo.ToPtr(60.0),
+				LongestOutage:          2 * time.Hour,
+				LongestOutageStartedAt: now.Add(-9 * time.Hour),
+			},
+		},
+		{
+			name:        "OngoingOutage",
+			firstSeenAt: now.Add(-4 * time.Hour),
+			runs:        []ServerRunPresence{run(4*time.Hour, true), run(3*time.Hour, true), run(2*time.Hour, false), run(time.Hour, false)},
+			expected: ServerUptime{
+				Availability24h:        lo.ToPtr(50.0),
+				Availability7d:         lo.ToPtr(50.0),
+				Availability30d:        lo.ToPtr(50.0),
+				LongestOutage:          2 * time.Hour,
+				LongestOutageStartedAt: now.Add(-2 * time.Hour),
+			},
+		},
+		{
+			name:        "RunsBeforeFirstSeen",
+			firstSeenAt: now.Add(-3 * time.Hour),
+			runs:        []ServerRunPresence{run(5*time.Hour, false), run(4*time.Hour, false), run(3*time.Hour, true), run(2*time.Hour, true)},
+			expected: ServerUptime{
+				Availability24h: lo.ToPtr(100.0),
+				Availability7d:  lo.ToPtr(100.0),
+				Availability30d: lo.ToPtr(100.0),
+			},
+		},
+		{
+			name:        "Windows",
+			firstSeenAt: now.Add(-60 * 24 * time.Hour),
+			runs: []ServerRunPresence{
+				run(40*24*time.Hour, false), run(20*24*time.Hour, true), run(10*24*time.Hour, true), run(3*24*time.Hour, false), run(time.Hour, true),
+			},
+			expected: ServerUptime{
+				Availability24h:        lo.ToPtr(100.0),
+				Availability7d:         lo.ToPtr(50.0),
+				Availability30d:        lo.ToPtr(75.0),
+				LongestOutage:          71 * time.Hour,
+				LongestOutageStartedAt: now.Add(-3 * 24 * time.Hour),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.expected.FirstSeenAt = tt.firstSeenAt
+			tt.expected.LastSeenAt = now
+
+			assert.Equal(t, tt.expected, NewServerUptime(now, tt.firstSeenAt, now, tt.runs))
+		})
+	}
+}
@@ -5,27 +5,42 @@ package domain
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 )
 
 // Repository ...
 type Repository interface {
-	InsertServers(ctx context.Context, servers []Server) error
+	InsertSnapshot(ctx context.Context, snapshot Snapshot) error
 	ListMultiplayerSummaries(ctx context.Context, playersOrderAsc bool) ([]MultiplayerSummary, error)
+	ListMultiplayerStatistics(ctx context.Context, params ListMultiplayerStatisticsParams) ([]MultiplayerStatisticPoint, error)
 	ListServerSummaries(ctx context.Context, params ListServerSummariesParams) ([]ServerSummary, error)
+	// ListServerSummariesPage is a keyset paginated ListServerSummaries, that is stable between collections.
+	ListServerSummariesPage(ctx context.Context, params ListServerSummariesPageParams) (ServerSummariesPage, error)
+	SearchServers(ctx context.Context, params SearchServersParams) (ServerSearchResult, error)
+	ListTrendingServers(ctx context.Context, params ListTrendingServersParams) ([]TrendingServer, error)
 	GetServer(ctx context.Context, multiplayer Multiplayer, host string) (Server, error)
 	ListServerStatistics(ctx context.Context, params ListServerStatisticsParams) ([]ServerStatisticPoint, error)
+	// GetServerUptime returns availability of the server by successful collection runs, that are not older than ServerUptimeMaxWindow.
+	GetServerUptime(ctx context.Context, multiplayer Multiplayer, host string) (ServerUptime, error)
+	// ListServerMetadata returns the last known metadata of all servers of the multiplayer.
+	ListServerMetadata(ctx context.Context, multiplayer Multiplayer) ([]Server, error)
+	InsertServerChanges(ctx context.Context, changes []ServerChange) error
+	ListServerChanges(ctx context.Context, params ListServerChangesParams) ([]ServerChange, error)
+	InsertCollectionRun(ctx context.Context, run CollectionRun) error
 }
 
 const (
 	serverStatisticsMaxTimeRangeDelta = time.Hour * 24 * 30 // 30 days
+	// serverStatisticsDailyMaxTimeRangeDelta is a limit of daily and weekly precisions, that are read from daily rollup.
+	serverStatisticsDailyMaxTimeRangeDelta = time.Hour * 24 * 365 * 2 // 2 years
+	// serverStatisticsMonthlyMaxTimeRangeDelta is a limit of monthly precision, that is read from monthly rollup.
+	serverStatisticsMonthlyMaxTimeRangeDelta = time.Hour * 24 * 365 * 10 // 10 years
 )
 
 var (
-	errBadLimit  = errors.New("limit must be greater or equal than zero")
-	errBadOffset = errors.New("offset must be greater than zero")
+	errBadLimit  = fmt.Errorf("%w: limit must be greater than zero", ErrInvalidParams)
+	errBadOffset = fmt.Errorf("%w: offset must be greater or equal than zero", ErrInvalidParams)
 )
 
 // ListServerSummariesParams ...
@@ -50,6 +65,141 @@ func (s ListServerSummariesParams) Validate() error {
 	return nil
 }
 
+// ServerOnlineFilter ...
+type ServerOnlineFilter uint8
+
+const (
+	// ServerOnlineFilterAny ...
+	ServerOnlineFilterAny ServerOnlineFilter = iota
+	// ServerOnlineFilterOnline keeps servers of the latest snapshot of the multiplayer.
+	ServerOnlineFilterOnline
+	// ServerOnlineFilterOffline keeps servers missing from the latest snapshot of the multiplayer.
+	ServerOnlineFilterOffline
+)
+
+var errBadPlayersRange = fmt.Errorf("%w: min players must be less or equal than max players", ErrInvalidParams)
+
+// ListServerSummariesPageParams ...
+type ListServerSummariesPageParams struct {
+	Multiplayer     Multiplayer
+	IncludeOffline  bool
+	PlayersOrderAsc bool
+	Limit           int32
+	// After is a cursor of the previous page, nil means the first page.
+	After *ServerSummaryCursor
+}
+
+// Validate ...
+func (s ListServerSummariesPageParams) Validate() error {
+	if s.Limit <= 0 {
+		return errBadLimit
+	}
+
+	if s.After != nil && (s.After.PlayersOrderAsc != s.PlayersOrderAsc || s.After.IncludeOffline != s.IncludeOffline) {
+		return errCursorParamsMismatch
+	}
+
+	return nil
+}
+
+// NextCursor returns cursor of the page, that ends with the server.
+func (s ListServerSummariesPageParams) NextCursor(playersCount int32, host string) *ServerSummaryCursor {
+	return &ServerSummaryCursor{
+		PlayersCount:    playersCount,
+		Host:            host,
+		PlayersOrderAsc: s.PlayersOrderAsc,
+		IncludeOffline:  s.IncludeOffline,
+	}
+}
+
+// SearchServersParams ...
+type SearchServersParams struct {
+	Multiplayer Multiplayer
+	// Query is a case-insensitive substring of server name.
+	Query    string
+	Language string
+	Gamemode string
+	// MinPlayers and MaxPlayers filter by current players count, nil means no bound.
+	MinPlayers *int32
+	MaxPlayers *int32
+	Online     ServerOnlineFilter
+	Limit      int32
+	Offset     int32
+}
+
+// Validate ...
+func (s SearchServersParams) Validate() error {
+	if s.Limit <= 0 {
+		return errBadLimit
+	}
+
+	if s.Offset < 0 {
+		return errBadOffset
+	}
+
+	if s.MinPlayers != nil && s.MaxPlayers != nil && *s.MinPlayers > *s.MaxPlayers {
+		return errBadPlayersRange
+	}
+
+	return nil
+}
+
+// TrendingWindow is a window, that average players are compared with the previous one of the same length.
+type TrendingWindow uint8
+
+const (
+	// TrendingWindowDay ...
+	TrendingWindowDay TrendingWindow = iota
+	// TrendingWindowWeek ...
+	TrendingWindowWeek
+	// TrendingWindowMonth ...
+	TrendingWindowMonth
+)
+
+// Duration ...
+func (w TrendingWindow) Duration() time.Duration {
+	switch w {
+	case TrendingWindowWeek:
+		return 7 * 24 * time.Hour
+	case TrendingWindowMonth:
+		return 30 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// TrendingOrder ...
+type TrendingOrder uint8
+
+const (
+	// TrendingOrderAbsolute orders by change of average players.
+	TrendingOrderAbsolute TrendingOrder = iota
+	// TrendingOrderRelative orders by change relative to average players of the previous window.
+	TrendingOrderRelative
+)
+
+// ListTrendingServersParams ...
+type ListTrendingServersParams struct {
+	Multiplayer Multiplayer
+	Window      TrendingWindow
+	OrderBy     TrendingOrder
+	Limit       int32
+	Offset      int32
+}
+
+// Validate ...
+func (s ListTrendingServersParams) Validate() error {
+	if s.Limit <= 0 {
+		return errBadLimit
+	}
+
+	if s.Offset < 0 {
+		return errBadOffset
+	}
+
+	return nil
+}
+
 // ServerStatisticsPrecision ...
 type ServerStatisticsPrecision uint8
 
@@ -58,6 +208,83 @@ const (
 	ServerStatisticsPrecisionPerHour ServerStatisticsPrecision = iota
 	// ServerStatisticsPrecisionPerDay ...
 	ServerStatisticsPrecisionPerDay
+	// ServerStatisticsPrecisionPerFiveMinutes ...
+	ServerStatisticsPrecisionPerFiveMinutes
+	// ServerStatisticsPrecisionPerFifteenMinutes ...
+	ServerStatisticsPrecisionPerFifteenMinutes
+	// ServerStatisticsPrecisionPerWeek ...
+	ServerStatisticsPrecisionPerWeek
+	// ServerStatisticsPrecisionPerMonth ...
+	ServerStatisticsPrecisionPerMonth
+)
+
+// maxTimeRangeDelta returns maximum time range, that may be requested with the precision.
+func (p ServerStatisticsPrecision) maxTimeRangeDelta() time.Duration {
+	switch p {
+	case ServerStatisticsPrecisionPerDay, ServerStatisticsPrecisionPerWeek:
+		return serverStatisticsDailyMaxTimeRangeDelta
+	case ServerStatisticsPrecisionPerMonth:
+		return serverStatisticsMonthlyMaxTimeRangeDelta
+	default:
+		return serverStatisticsMaxTimeRangeDelta
+	}
+}
+
+// BucketStart returns the start of the bucket of t, buckets are aligned in UTC and weeks start on Monday.
+func (p ServerStatisticsPrecision) BucketStart(t time.Time) time.Time {
+	t = t.UTC()
+
+	switch p {
+	case ServerStatisticsPrecisionPerFiveMinutes:
+		return t.Truncate(5 * time.Minute)
+	case ServerStatisticsPrecisionPerFifteenMinutes:
+		return t.Truncate(15 * time.Minute)
+	case ServerStatisticsPrecisionPerDay:
+		return t.Truncate(24 * time.Hour)
+	case ServerStatisticsPrecisionPerWeek:
+		// zero time is Monday, so truncation aligns weeks to Monday.
+		return t.Truncate(7 * 24 * time.Hour)
+	case ServerStatisticsPrecisionPerMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return t.Truncate(time.Hour)
+	}
+}
+
+// NextBucket returns the start of the bucket following the bucket starting at t.
+func (p ServerStatisticsPrecision) NextBucket(t time.Time) time.Time {
+	switch p {
+	case ServerStatisticsPrecisionPerFiveMinutes:
+		return t.Add(5 * time.Minute)
+	case ServerStatisticsPrecisionPerFifteenMinutes:
+		return t.Add(15 * time.Minute)
+	case ServerStatisticsPrecisionPerDay:
+		return t.AddDate(0, 0, 1)
+	case ServerStatisticsPrecisionPerWeek:
+		return t.AddDate(0, 0, 7)
+	case ServerStatisticsPrecisionPerMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.Add(time.Hour)
+	}
+}
+
+// ServerStatisticAggregate is an aggregate of players count in the statistics bucket.
+type ServerStatisticAggregate uint8
+
+const (
+	// ServerStatisticAggregateAvg ...
+	ServerStatisticAggregateAvg ServerStatisticAggregate = iota
+	// ServerStatisticAggregateMin ...
+	ServerStatisticAggregateMin
+	// ServerStatisticAggregateMax ...
+	ServerStatisticAggregateMax
+	// ServerStatisticAggregateP50 ...
+	ServerStatisticAggregateP50
+	// ServerStatisticAggregateP95 ...
+	ServerStatisticAggregateP95
+	// ServerStatisticAggregateCount is a count of samples in the bucket.
+	ServerStatisticAggregateCount
 )
 
 // TimeRange is a type that represents a range of time.
@@ -67,8 +294,8 @@ type TimeRange struct {
 }
 
 var (
-	errIncorrectTimeRange     = errors.New("time range is incorrect, 'From' must be greater than 'To'")
-	errTimeRangeDeltaOverflow = errors.New("delta bigger then max")
+	errIncorrectTimeRange     = fmt.Errorf("%w: time range is incorrect, 'From' must be greater than 'To'", ErrInvalidParams)
+	errTimeRangeDeltaOverflow = fmt.Errorf("%w: delta bigger then max", ErrInvalidParams)
 )
 
 // Validate ...
@@ -94,13 +321,54 @@ type ListServerStatisticsParams struct {
 	Host        string
 	TimeRange   TimeRange
 	Precision   ServerStatisticsPrecision
+	// Aggregates are computed in addition to average, that is always computed.
+	Aggregates []ServerStatisticAggregate
+	// Fill makes series dense, buckets without server are returned with status other than online.
+	Fill bool
 }
 
 // Validate ...
 func (s ListServerStatisticsParams) Validate() error {
-	if err := s.TimeRange.Validate(serverStatisticsMaxTimeRangeDelta); err != nil {
+	if err := s.TimeRange.Validate(s.Precision.maxTimeRangeDelta()); err != nil {
+		return fmt.Errorf("s.TimeRange.Validate: %w", err)
+	}
+
+	return nil
+}
+
+// ListMultiplayerStatisticsParams ...
+type ListMultiplayerStatisticsParams struct {
+	Multiplayer Multiplayer
+	TimeRange   TimeRange
+	Precision   ServerStatisticsPrecision
+}
+
+// Validate ...
+func (s ListMultiplayerStatisticsParams) Validate() error {
+	if err := s.TimeRange.Validate(s.Precision.maxTimeRangeDelta()); err != nil {
 		return fmt.Errorf("s.TimeRange.Validate: %w", err)
 	}
 
 	return nil
 }
+
+// ListServerChangesParams ...
+type ListServerChangesParams struct {
+	Multiplayer Multiplayer
+	Host        string
+	Limit       int32
+	Offset      int32
+}
+
+// Validate ...
+func (s ListServerChangesParams) Validate() error {
+	if s.Limit <= 0 {
+		return errBadLimit
+	}
+
+	if s.Offset < 0 {
+		return errBadOffset
+	}
+
+	return nil
+}
@@ -155,6 +155,73 @@ func TestListServerStatisticsParams_Validate(t *testing.T) {
 			params:  ListServerStatisticsParams{},
 			wantErr: true,
 		},
+		{
+			name: "ValidLongRangePerMonth",
+			params: ListServerStatisticsParams{
+				TimeRange: TimeRange{
+					From: testTime.AddDate(-5, 0, 0),
+					To:   testTime,
+				},
+				Precision: ServerStatisticsPrecisionPerMonth,
+			},
+			wantErr: false,
+		},
+		{
+			name: "InvalidLongRangePerHour",
+			params: ListServerStatisticsParams{
+				TimeRange: TimeRange{
+					From: testTime.AddDate(0, -2, 0),
+					To:   testTime,
+				},
+				Precision: ServerStatisticsPrecisionPerHour,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.wantErr, tt.params.Validate() != nil)
+		})
+	}
+}
+
+func TestListMultiplayerStatisticsParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	testTime := time.Now().Truncate(time.Hour)
+
+	tests := []struct {
+		name    string
+		params  ListMultiplayerStatisticsParams
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			params: ListMultiplayerStatisticsParams{
+				TimeRange: TimeRange{
+					From: testTime,
+					To:   testTime.Add(time.Hour),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Invalid",
+			params:  ListMultiplayerStatisticsParams{},
+			wantErr: true,
+		},
+		{
+			name: "InvalidLongRangePerDay",
+			params: ListMultiplayerStatisticsParams{
+				TimeRange: TimeRange{
+					From: testTime.AddDate(-3, 0, 0),
+					To:   testTime,
+				},
+				Precision: ServerStatisticsPrecisionPerDay,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -163,3 +230,181 @@ func TestListServerStatisticsParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestListTrendingServersParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		params  ListTrendingServersParams
+		wantErr error
+	}{
+		{
+			name:   "Valid",
+			params: ListTrendingServersParams{Window: TrendingWindowWeek, Limit: 10},
+		},
+		{
+			name:    "BadLimit",
+			params:  ListTrendingServersParams{},
+			wantErr: errBadLimit,
+		},
+		{
+			name:    "BadOffset",
+			params:  ListTrendingServersParams{Limit: 10, Offset: -1},
+			wantErr: errBadOffset,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, tt.params.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestListServerSummariesPageParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		params  ListServerSummariesPageParams
+		wantErr error
+	}{
+		{
+			name:   "Valid",
+			params: ListServerSummariesPageParams{Limit: 10},
+		},
+		{
+			name: "ValidCursor",
+			params: ListServerSummariesPageParams{
+				IncludeOffline: true,
+				Limit:          10,
+				After:          ListServerSummariesPageParams{IncludeOffline: true}.NextCursor(5, "a"),
+			},
+		},
+		{
+			name:    "BadLimit",
+			params:  ListServerSummariesPageParams{},
+			wantErr: errBadLimit,
+		},
+		{
+			name: "CursorOfOtherOrder",
+			params: ListServerSummariesPageParams{
+				Limit: 10,
+				After: ListServerSummariesPageParams{PlayersOrderAsc: true}.NextCursor(5, "a"),
+			},
+			wantErr: ErrBadCursor,
+		},
+		{
+			name: "CursorOfOtherOfflineFilter",
+			params: ListServerSummariesPageParams{
+				Limit: 10,
+				After: ListServerSummariesPageParams{IncludeOffline: true}.NextCursor(5, "a"),
+			},
+			wantErr: ErrBadCursor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, tt.params.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestSearchServersParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		five = int32(5)
+		ten  = int32(10)
+	)
+
+	tests := []struct {
+		name    string
+		params  SearchServersParams
+		wantErr error
+	}{
+		{
+			name:   "Valid",
+			params: SearchServersParams{MinPlayers: &five, MaxPlayers: &ten, Limit: 10},
+		},
+		{
+			name:    "BadLimit",
+			params:  SearchServersParams{},
+			wantErr: errBadLimit,
+		},
+		{
+			name:    "BadPlayersRange",
+			params:  SearchServersParams{MinPlayers: &ten, MaxPlayers: &five, Limit: 10},
+			wantErr: errBadPlayersRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, tt.params.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestServerStatisticsPrecision_BucketStart(t *testing.T) {
+	t.Parallel()
+
+	// Thursday.
+	at := time.Date(2025, 1, 16, 13, 47, 12, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		precision ServerStatisticsPrecision
+		expected  time.Time
+		next      time.Time
+	}{
+		{
+			name:      "PerFiveMinutes",
+			precision: ServerStatisticsPrecisionPerFiveMinutes,
+			expected:  time.Date(2025, 1, 16, 13, 45, 0, 0, time.UTC),
+			next:      time.Date(2025, 1, 16, 13, 50, 0, 0, time.UTC),
+		},
+		{
+			name:      "PerFifteenMinutes",
+			precision: ServerStatisticsPrecisionPerFifteenMinutes,
+			expected:  time.Date(2025, 1, 16, 13, 45, 0, 0, time.UTC),
+			next:      time.Date(2025, 1, 16, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "PerHour",
+			precision: ServerStatisticsPrecisionPerHour,
+			expected:  time.Date(2025, 1, 16, 13, 0, 0, 0, time.UTC),
+			next:      time.Date(2025, 1, 16, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "PerDay",
+			precision: ServerStatisticsPrecisionPerDay,
+			expected:  time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC),
+			next:      time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "PerWeekStartsOnMonday",
+			precision: ServerStatisticsPrecisionPerWeek,
+			expected:  time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+			next:      time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "PerMonth",
+			precision: ServerStatisticsPrecisionPerMonth,
+			expected:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			next:      time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start := tt.precision.BucketStart(at)
+
+			assert.Equal(t, tt.expected, start)
+			assert.Equal(t, tt.next, tt.precision.NextBucket(start))
+		})
+	}
+}
@@ -0,0 +1,1006 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+// Package repotest is a conformance suite of domain.Repository, that every storage backend must pass.
+package repotest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+)
+
+// fixture is a multiplayer with two snapshots an hour apart, server "c" is missing from the latest one.
+type fixture struct {
+	multiplayer domain.Multiplayer
+	// small is a multiplayer with fewer players, than multiplayer has.
+	small domain.Multiplayer
+	// history is a multiplayer with old snapshots, that are bucketed by statistics.
+	history domain.Multiplayer
+	// trending is a multiplayer with snapshots in the current and the previous trending day windows.
+	trending domain.Multiplayer
+
+	// firstAt is a time of the first snapshot, that is the start of an hour.
+	firstAt  time.Time
+	latestAt time.Time
+	// day is the start of the day of history snapshots.
+	day time.Time
+}
+
+// Run runs the suite against repo. Every run writes its data under new multiplayers,
+// so repo may be backed by a shared database, that is not cleaned between runs.
+func Run(t *testing.T, repo domain.Repository) {
+	t.Helper()
+
+	f := insertFixture(t, repo)
+
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo domain.Repository, f fixture)
+	}{
+		{name: "ListMultiplayerSummaries", test: testListMultiplayerSummaries},
+		{name: "ListServerSummaries", test: testListServerSummaries},
+		{name: "ListServerSummariesPage", test: testListServerSummariesPage},
+		{name: "GetServer", test: testGetServer},
+		{name: "NotFound", test: testNotFound},
+		{name: "Validation", test: testValidation},
+		{name: "ListServerStatistics", test: testListServerStatistics},
+		{name: "ListServerStatisticsBuckets", test: testListServerStatisticsBuckets},
+		{name: "ListServerStatisticsFill", test: testListServerStatisticsFill},
+		{name: "GetServerUptime", test: testGetServerUptime},
+		{name: "SearchServers", test: testSearchServers},
+		{name: "ListTrendingServers", test: testListTrendingServers},
+		{name: "ListServerChanges", test: testListServerChanges},
+		{name: "ListMultiplayerStatistics", test: testListMultiplayerStatistics},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.test(t, repo, f)
+		})
+	}
+}
+
+func insertFixture(t *testing.T, repo domain.Repository) fixture {
+	t.Helper()
+
+	multiplayer := fmt.Sprintf("repotest_%d", time.Now().UnixNano())
+
+	f := fixture{
+		multiplayer: domain.Multiplayer(multiplayer),
+		small:       domain.Multiplayer(multiplayer + "_small"),
+		history:     domain.Multiplayer(multiplayer + "_history"),
+		trending:    domain.Multiplayer(multiplayer + "_trending"),
+		firstAt:     time.Now().UTC().Truncate(time.Hour).Add(-2 * time.Hour),
+		day:         time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -3),
+	}
+	f.latestAt = f.firstAt.Add(time.Hour)
+
+	server := func(multiplayer domain.Multiplayer, host string, playersCount int32, collectedAt time.Time) domain.Server {
+		return domain.Server{
+			Multiplayer:  multiplayer,
+			Host:         host,
+			Name:         "Server " + host,
+			URL:          "https://" + host,
+			Gamemode:     "roleplay",
+			Language:     "en",
+			PlayersCount: playersCount,
+			MaxPlayers:   100,
+			PeakPlayers:  150,
+			Map:          "San Andreas",
+			Version:      "1.0",
+			Passworded:   lo.ToPtr(true),
+			Tags:         []string{"tag"},
+			CollectedAt:  collectedAt,
+		}
+	}
+
+	// b is a server of other language, that is found by search filters.
+	russian := func(collectedAt time.Time, playersCount int32) domain.Server {
+		s := server(f.multiplayer, "b", playersCount, collectedAt)
+		s.Language = "ru"
+
+		return s
+	}
+
+	// c is a server of the platform, that doesn't report password flag.
+	unknownPassword := server(f.multiplayer, "c", 5, f.firstAt)
+	unknownPassword.Passworded = nil
+
+	// trendingBefore is in the previous trending day window, firstAt is in the current one.
+	trendingBefore := f.firstAt.Add(-24 * time.Hour)
+
+	snapshots := []domain.Snapshot{
+		domain.NewSnapshot(f.multiplayer, f.firstAt, []domain.Server{
+			server(f.multiplayer, "a", 10, f.firstAt),
+			russian(f.firstAt, 20),
+			unknownPassword,
+		}),
+		domain.NewSnapshot(f.multiplayer, f.latestAt, []domain.Server{
+			server(f.multiplayer, "a", 30, f.latestAt),
+			russian(f.latestAt, 10),
+		}),
+		domain.NewSnapshot(f.small, f.latestAt, []domain.Server{
+			server(f.small, "a", 5, f.latestAt),
+		}),
+		domain.NewSnapshot(f.trending, trendingBefore, []domain.Server{
+			server(f.trending, "a", 10, trendingBefore),
+			server(f.trending, "b", 20, trendingBefore),
+		}),
+		domain.NewSnapshot(f.trending, f.firstAt, []domain.Server{
+			server(f.trending, "a", 30, f.firstAt),
+			server(f.trending, "b", 10, f.firstAt),
+			server(f.trending, "c", 5, f.firstAt),
+		}),
+	}
+
+	history := []struct {
+		offset       time.Duration
+		playersCount int32
+	}{
+		{offset: time.Hour, playersCount: 10},
+		{offset: 90 * time.Minute, playersCount: 20},
+		{offset: 2 * time.Hour, playersCount: 30},
+		{offset: 29 * time.Hour, playersCount: 40},
+	}
+
+	for _, sample := range history {
+		collectedAt := f.day.Add(sample.offset)
+
+		snapshots = append(snapshots, domain.NewSnapshot(f.history, collectedAt, []domain.Server{
+			server(f.history, "a", sample.playersCount, collectedAt),
+		}))
+	}
+
+	for _, snapshot := range snapshots {
+		// the second insert is a retry, that must not duplicate the snapshot.
+		for range 2 {
+			require.NoError(t, repo.InsertSnapshot(t.Context(), snapshot))
+		}
+	}
+
+	runs := []domain.CollectionRun{
+		{Multiplayer: f.history, CollectedAt: f.day.Add(10 * time.Minute), Status: domain.CollectionRunStatusFailed},
+		{Multiplayer: f.history, CollectedAt: f.day.Add(time.Hour), Status: domain.CollectionRunStatusOK, ServersCount: 1},
+		{Multiplayer: f.history, CollectedAt: f.day.Add(3 * time.Hour), Status: domain.CollectionRunStatusOK},
+		{Multiplayer: f.history, CollectedAt: f.day.Add(29 * time.Hour), Status: domain.CollectionRunStatusOK, ServersCount: 1},
+		{Multiplayer: f.multiplayer, CollectedAt: f.firstAt, Status: domain.CollectionRunStatusOK, ServersCount: 3},
+		{Multiplayer: f.multiplayer, CollectedAt: f.firstAt.Add(30 * time.Minute), Status: domain.CollectionRunStatusFailed},
+		{Multiplayer: f.multiplayer, CollectedAt: f.latestAt, Status: domain.CollectionRunStatusOK, ServersCount: 2},
+	}
+
+	for _, run := range runs {
+		require.NoError(t, repo.InsertCollectionRun(t.Context(), run))
+	}
+
+	// changes are inserted per snapshot, as collector does.
+	changes := [][]domain.ServerChange{
+		{
+			{Multiplayer: f.multiplayer, Host: "b", Field: domain.ServerFieldLanguage, OldValue: "en", NewValue: "ru", ChangedAt: f.firstAt},
+		},
+		{
+			{Multiplayer: f.multiplayer, Host: "b", Field: domain.ServerFieldName, OldValue: "Old b", NewValue: "Server b", ChangedAt: f.latestAt},
+			{Multiplayer: f.multiplayer, Host: "b", Field: domain.ServerFieldGamemode, OldValue: "freeroam", NewValue: "roleplay", ChangedAt: f.latestAt},
+		},
+	}
+
+	for _, snapshotChanges := range changes {
+		// the second insert is a retry, that must not duplicate the changes.
+		for range 2 {
+			require.NoError(t, repo.InsertServerChanges(t.Context(), snapshotChanges))
+		}
+	}
+
+	return f
+}
+
+func testListMultiplayerSummaries(t *testing.T, repo domain.Repository, f fixture) {
+	tests := []struct {
+		name            string
+		playersOrderAsc bool
+		expected        []domain.Multiplayer
+	}{
+		{
+			name:     "Desc",
+			expected: []domain.Multiplayer{f.multiplayer, f.small},
+		},
+		{
+			name:            "Asc",
+			playersOrderAsc: true,
+			expected:        []domain.Multiplayer{f.small, f.multiplayer},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summaries, err := repo.ListMultiplayerSummaries(t.Context(), tt.playersOrderAsc)
+			require.NoError(t, err)
+
+			// repo may have multiplayers of other runs, so only relative order of fixture ones is checked.
+			summaries = lo.Filter(summaries, func(summary domain.MultiplayerSummary, _ int) bool {
+				return lo.Contains(tt.expected, summary.Name)
+			})
+
+			assert.Equal(t, tt.expected, lo.Map(summaries, func(summary domain.MultiplayerSummary, _ int) domain.Multiplayer {
+				return summary.Name
+			}))
+		})
+	}
+
+	summaries, err := repo.ListMultiplayerSummaries(t.Context(), false)
+	require.NoError(t, err)
+
+	summary, ok := lo.Find(summaries, func(summary domain.MultiplayerSummary) bool {
+		return summary.Name == f.multiplayer
+	})
+	require.True(t, ok)
+	assert.Equal(t, int64(40), summary.PlayersCount)
+
+	// snapshots older than a day are not the latest ones.
+	assert.False(t, lo.ContainsBy(summaries, func(summary domain.MultiplayerSummary) bool {
+		return summary.Name == f.history
+	}))
+}
+
+func testListServerSummaries(t *testing.T, repo domain.Repository, f fixture) {
+	var (
+		a = domain.ServerSummary{Host: "a", Name: "Server a", PlayersCount: 30, Online: true, LastSeenAt: f.latestAt}
+		b = domain.ServerSummary{Host: "b", Name: "Server b", PlayersCount: 10, Online: true, LastSeenAt: f.latestAt}
+		c = domain.ServerSummary{Host: "c", Name: "Server c", PlayersCount: 0, Online: false, LastSeenAt: f.firstAt}
+	)
+
+	tests := []struct {
+		name     string
+		params   domain.ListServerSummariesParams
+		expected []domain.ServerSummary
+	}{
+		{
+			name: "Desc",
+			params: domain.ListServerSummariesParams{
+				Multiplayer: f.multiplayer,
+				Limit:       10,
+			},
+			expected: []domain.ServerSummary{a, b},
+		},
+		{
+			name: "Asc",
+			params: domain.ListServerSummariesParams{
+				Multiplayer:     f.multiplayer,
+				PlayersOrderAsc: true,
+				Limit:           10,
+			},
+			expected: []domain.ServerSummary{b, a},
+		},
+		{
+			name: "IncludeOffline",
+			params: domain.ListServerSummariesParams{
+				Multiplayer:    f.multiplayer,
+				IncludeOffline: true,
+				Limit:          10,
+			},
+			expected: []domain.ServerSummary{a, b, c},
+		},
+		{
+			// servers of the latest snapshot go first regardless of order.
+			name: "IncludeOfflineAsc",
+			params: domain.ListServerSummariesParams{
+				Multiplayer:     f.multiplayer,
+				IncludeOffline:  true,
+				PlayersOrderAsc: true,
+				Limit:           10,
+			},
+			expected: []domain.ServerSummary{b, a, c},
+		},
+		{
+			name: "Offset",
+			params: domain.ListServerSummariesParams{
+				Multiplayer: f.multiplayer,
+				Limit:       1,
+				Offset:      1,
+			},
+			expected: []domain.ServerSummary{b},
+		},
+		{
+			name: "OffsetOverflow",
+			params: domain.ListServerSummariesParams{
+				Multiplayer:    f.multiplayer,
+				IncludeOffline: true,
+				Limit:          10,
+				Offset:         3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			servers, err := repo.ListServerSummaries(t.Context(), tt.params)
+			require.NoError(t, err)
+
+			assert.Equal(t, summaryHosts(tt.expected), summaryHosts(servers))
+			assert.ElementsMatch(t, tt.expected, lo.Map(servers, func(server domain.ServerSummary, _ int) domain.ServerSummary {
+				server.LastSeenAt = server.LastSeenAt.UTC()
+				return server
+			}))
+		})
+	}
+}
+
+func testListServerSummariesPage(t *testing.T, repo domain.Repository, f fixture) {
+	tests := []struct {
+		name            string
+		includeOffline  bool
+		playersOrderAsc bool
+		expectedTotal   uint64
+		expected        [][]string
+	}{
+		{
+			name:          "Desc",
+			expectedTotal: 2,
+			expected:      [][]string{{"a", "b"}},
+		},
+		{
+			name:            "Asc",
+			playersOrderAsc: true,
+			expectedTotal:   2,
+			expected:        [][]string{{"b", "a"}},
+		},
+		{
+			name:           "IncludeOffline",
+			includeOffline: true,
+			expectedTotal:  3,
+			expected:       [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			name:            "IncludeOfflineAsc",
+			includeOffline:  true,
+			playersOrderAsc: true,
+			expectedTotal:   3,
+			expected:        [][]string{{"c", "b"}, {"a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := domain.ListServerSummariesPageParams{
+				Multiplayer:     f.multiplayer,
+				IncludeOffline:  tt.includeOffline,
+				PlayersOrderAsc: tt.playersOrderAsc,
+				Limit:           2,
+			}
+
+			var pages [][]string
+
+			for {
+				page, err := repo.ListServerSummariesPage(t.Context(), params)
+				require.NoError(t, err)
+				require.Less(t, len(pages), len(tt.expected), "too many pages")
+
+				assert.Equal(t, tt.expectedTotal, page.Total)
+
+				pages = append(pages, summaryHosts(page.Servers))
+
+				if page.NextCursor == nil {
+					break
+				}
+
+				params.After = page.NextCursor
+			}
+
+			assert.Equal(t, tt.expected, pages)
+		})
+	}
+}
+
+func testGetServer(t *testing.T, repo domain.Repository, f fixture) {
+	server, err := repo.GetServer(t.Context(), f.multiplayer, "a")
+	require.NoError(t, err)
+
+	assert.Equal(t, f.multiplayer, server.Multiplayer)
+	assert.Equal(t, "a", server.Host)
+	assert.Equal(t, "Server a", server.Name)
+	assert.Equal(t, "https://a", server.URL)
+	assert.Equal(t, "roleplay", server.Gamemode)
+	assert.Equal(t, "en", server.Language)
+	assert.Equal(t, int32(30), server.PlayersCount)
+	assert.Equal(t, int32(100), server.MaxPlayers)
+	assert.Equal(t, int32(150), server.PeakPlayers)
+	assert.Equal(t, "San Andreas", server.Map)
+	assert.Equal(t, lo.ToPtr(true), server.Passworded)
+	assert.Equal(t, []string{"tag"}, server.Tags)
+	assert.True(t, f.latestAt.Equal(server.CollectedAt))
+
+	offline, err := repo.GetServer(t.Context(), f.multiplayer, "c")
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), offline.PlayersCount)
+	assert.Nil(t, offline.Passworded)
+	assert.True(t, f.firstAt.Equal(offline.CollectedAt))
+}
+
+func testNotFound(t *testing.T, repo domain.Repository, f fixture) {
+	timeRange := domain.TimeRange{
+		From: f.firstAt.Add(-time.Minute),
+		To:   time.Now(),
+	}
+
+	tests := []struct {
+		name     string
+		call     func() error
+		expected error
+	}{
+		{
+			name: "GetServer",
+			call: func() error {
+				_, err := repo.GetServer(t.Context(), f.multiplayer, "unknown")
+				return err
+			},
+			expected: domain.ErrServerNotFound,
+		},
+		{
+			name: "GetServerOfOtherMultiplayer",
+			call: func() error {
+				_, err := repo.GetServer(t.Context(), f.small, "b")
+				return err
+			},
+			expected: domain.ErrServerNotFound,
+		},
+		{
+			name: "ListServerStatistics",
+			call: func() error {
+				_, err := repo.ListServerStatistics(t.Context(), domain.ListServerStatisticsParams{
+					Multiplayer: f.multiplayer,
+					Host:        "unknown",
+					TimeRange:   timeRange,
+				})
+				return err
+			},
+			expected: domain.ErrServerNotFound,
+		},
+		{
+			name: "GetServerUptime",
+			call: func() error {
+				_, err := repo.GetServerUptime(t.Context(), f.multiplayer, "unknown")
+				return err
+			},
+			expected: domain.ErrServerNotFound,
+		},
+		{
+			name: "ListServerChanges",
+			call: func() error {
+				_, err := repo.ListServerChanges(t.Context(), domain.ListServerChangesParams{
+					Multiplayer: f.multiplayer,
+					Host:        "unknown",
+					Limit:       10,
+				})
+				return err
+			},
+			expected: domain.ErrServerNotFound,
+		},
+		{
+			name: "ListMultiplayerStatistics",
+			call: func() error {
+				_, err := repo.ListMultiplayerStatistics(t.Context(), domain.ListMultiplayerStatisticsParams{
+					Multiplayer: f.multiplayer + "_unknown",
+					TimeRange:   timeRange,
+				})
+				return err
+			},
+			expected: domain.ErrMultiplayerNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.call(), tt.expected)
+		})
+	}
+
+	// known server without changes has empty history.
+	changes, err := repo.ListServerChanges(t.Context(), domain.ListServerChangesParams{
+		Multiplayer: f.multiplayer,
+		Host:        "a",
+		Limit:       10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func testValidation(t *testing.T, repo domain.Repository, f fixture) {
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "ListServerSummariesLimit",
+			call: func() error {
+				_, err := repo.ListServerSummaries(t.Context(), domain.ListServerSummariesParams{Multiplayer: f.multiplayer})
+				return err
+			},
+		},
+		{
+			name: "ListServerSummariesOffset",
+			call: func() error {
+				_, err := repo.ListServerSummaries(t.Context(), domain.ListServerSummariesParams{
+					Multiplayer: f.multiplayer,
+					Limit:       10,
+					Offset:      -1,
+				})
+				return err
+			},
+		},
+		{
+			name: "ListServerSummariesPageLimit",
+			call: func() error {
+				_, err := repo.ListServerSummariesPage(t.Context(), domain.ListServerSummariesPageParams{Multiplayer: f.multiplayer})
+				return err
+			},
+		},
+		{
+			name: "ListServerSummariesPageCursorParams",
+			call: func() error {
+				_, err := repo.ListServerSummariesPage(t.Context(), domain.ListServerSummariesPageParams{
+					Multiplayer: f.multiplayer,
+					Limit:       10,
+					After:       domain.ListServerSummariesPageParams{PlayersOrderAsc: true}.NextCursor(10, "a"),
+				})
+				return err
+			},
+		},
+		{
+			name: "ListServerStatisticsTimeRange",
+			call: func() error {
+				_, err := repo.ListServerStatistics(t.Context(), domain.ListServerStatisticsParams{
+					Multiplayer: f.multiplayer,
+					Host:        "a",
+					TimeRange: domain.TimeRange{
+						From: f.latestAt,
+						To:   f.firstAt,
+					},
+				})
+				return err
+			},
+		},
+		{
+			name: "ListServerChangesLimit",
+			call: func() error {
+				_, err := repo.ListServerChanges(t.Context(), domain.ListServerChangesParams{
+					Multiplayer: f.multiplayer,
+					Host:        "a",
+				})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, tt.call())
+		})
+	}
+}
+
+func testListServerStatistics(t *testing.T, repo domain.Repository, f fixture) {
+	statistics, err := repo.ListServerStatistics(t.Context(), domain.ListServerStatisticsParams{
+		Multiplayer: f.multiplayer,
+		Host:        "a",
+		TimeRange: domain.TimeRange{
+			From: f.firstAt.Add(-time.Minute),
+			To:   time.Now(),
+		},
+		Precision:  domain.ServerStatisticsPrecisionPerHour,
+		Aggregates: []domain.ServerStatisticAggregate{domain.ServerStatisticAggregateCount},
+	})
+	require.NoError(t, err)
+	require.Len(t, statistics, 2)
+
+	assert.Equal(t, domain.ServerStatisticStatusOnline, statistics[0].Status)
+	assert.Equal(t, int32(30), statistics[0].PlayersCount)
+	assert.Equal(t, uint64(1), statistics[0].Samples)
+	assert.True(t, f.latestAt.Equal(statistics[0].CollectedAt))
+
+	assert.Equal(t, domain.ServerStatisticStatusOnline, statistics[1].Status)
+	assert.Equal(t, int32(10), statistics[1].PlayersCount)
+	assert.True(t, f.firstAt.Equal(statistics[1].CollectedAt))
+}
+
+// statisticPoint is a comparable part of domain.ServerStatisticPoint.
+type statisticPoint struct {
+	collectedAt  time.Time
+	playersCount int32
+	playersMin   int32
+	playersMax   int32
+	samples      uint64
+}
+
+func testListServerStatisticsBuckets(t *testing.T, repo domain.Repository, f fixture) {
+	tests := []struct {
+		name      string
+		precision domain.ServerStatisticsPrecision
+		expected  []statisticPoint
+	}{
+		{
+			name:      "PerHour",
+			precision: domain.ServerStatisticsPrecisionPerHour,
+			expected: []statisticPoint{
+				{collectedAt: f.day.Add(29 * time.Hour), playersCount: 40, playersMin: 40, playersMax: 40, samples: 1},
+				{collectedAt: f.day.Add(2 * time.Hour), playersCount: 30, playersMin: 30, playersMax: 30, samples: 1},
+				{collectedAt: f.day.Add(time.Hour), playersCount: 15, playersMin: 10, playersMax: 20, samples: 2},
+			},
+		},
+		{
+			name:      "PerDay",
+			precision: domain.ServerStatisticsPrecisionPerDay,
+			expected: []statisticPoint{
+				{collectedAt: f.day.AddDate(0, 0, 1), playersCount: 40, playersMin: 40, playersMax: 40, samples: 1},
+				{collectedAt: f.day, playersCount: 20, playersMin: 10, playersMax: 30, samples: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statistics, err := repo.ListServerStatistics(t.Context(), domain.ListServerStatisticsParams{
+				Multiplayer: f.history,
+				Host:        "a",
+				TimeRange: domain.TimeRange{
+					From: f.day.Add(-time.Minute),
+					To:   time.Now(),
+				},
+				Precision: tt.precision,
+				Aggregates: []domain.ServerStatisticAggregate{
+					domain.ServerStatisticAggregateMin,
+					domain.ServerStatisticAggregateMax,
+					domain.ServerStatisticAggregateCount,
+				},
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, lo.Map(statistics, func(point domain.ServerStatisticPoint, _ int) statisticPoint {
+				assert.Equal(t, domain.ServerStatisticStatusOnline, point.Status)
+
+				return statisticPoint{
+					collectedAt:  point.CollectedAt.UTC(),
+					playersCount: point.PlayersCount,
+					playersMin:   point.PlayersMin,
+					playersMax:   point.PlayersMax,
+					samples:      point.Samples,
+				}
+			}))
+		})
+	}
+}
+
+func testListServerStatisticsFill(t *testing.T, repo domain.Repository, f fixture) {
+	statistics, err := repo.ListServerStatistics(t.Context(), domain.ListServerStatisticsParams{
+		Multiplayer: f.history,
+		Host:        "a",
+		TimeRange: domain.TimeRange{
+			From: f.day,
+			To:   f.day.Add(4 * time.Hour),
+		},
+		Precision: domain.ServerStatisticsPrecisionPerHour,
+		Fill:      true,
+	})
+	require.NoError(t, err)
+
+	type point struct {
+		collectedAt time.Time
+		status      domain.ServerStatisticStatus
+	}
+
+	assert.Equal(t, []point{
+		{collectedAt: f.day.Add(3 * time.Hour), status: domain.ServerStatisticStatusOffline},
+		{collectedAt: f.day.Add(2 * time.Hour), status: domain.ServerStatisticStatusOnline},
+		{collectedAt: f.day.Add(time.Hour), status: domain.ServerStatisticStatusOnline},
+		{collectedAt: f.day, status: domain.ServerStatisticStatusCollectionFailed},
+	}, lo.Map(statistics, func(statistic domain.ServerStatisticPoint, _ int) point {
+		return point{collectedAt: statistic.CollectedAt.UTC(), status: statistic.Status}
+	}))
+}
+
+func testGetServerUptime(t *testing.T, repo domain.Repository, f fixture) {
+	t.Run("Online", func(t *testing.T) {
+		uptime, err := repo.GetServerUptime(t.Context(), f.multiplayer, "a")
+		require.NoError(t, err)
+
+		assert.True(t, f.firstAt.Equal(uptime.FirstSeenAt))
+		assert.True(t, f.latestAt.Equal(uptime.LastSeenAt))
+		assert.Equal(t, lo.ToPtr(100.0), uptime.Availability24h)
+		assert.Equal(t, lo.ToPtr(100.0), uptime.Availability7d)
+		assert.Equal(t, lo.ToPtr(100.0), uptime.Availability30d)
+		assert.Zero(t, uptime.LongestOutage)
+	})
+
+	t.Run("OngoingOutage", func(t *testing.T) {
+		minOutage := time.Since(f.latestAt)
+
+		uptime, err := repo.GetServerUptime(t.Context(), f.multiplayer, "c")
+		require.NoError(t, err)
+
+		assert.True(t, f.firstAt.Equal(uptime.FirstSeenAt))
+		assert.True(t, f.firstAt.Equal(uptime.LastSeenAt))
+		// failed collection run is not counted against the server.
+		assert.Equal(t, lo.ToPtr(50.0), uptime.Availability24h)
+		assert.True(t, f.latestAt.Equal(uptime.LongestOutageStartedAt))
+		assert.GreaterOrEqual(t, uptime.LongestOutage, minOutage)
+	})
+
+	t.Run("CompletedOutage", func(t *testing.T) {
+		uptime, err := repo.GetServerUptime(t.Context(), f.history, "a")
+		require.NoError(t, err)
+
+		assert.True(t, f.day.Add(time.Hour).Equal(uptime.FirstSeenAt))
+		assert.True(t, f.day.Add(29*time.Hour).Equal(uptime.LastSeenAt))
+		assert.Nil(t, uptime.Availability24h)
+		require.NotNil(t, uptime.Availability7d)
+		assert.InDelta(t, 200.0/3, *uptime.Availability7d, 0.001)
+		require.NotNil(t, uptime.Availability30d)
+		assert.InDelta(t, 200.0/3, *uptime.Availability30d, 0.001)
+		assert.Equal(t, 26*time.Hour, uptime.LongestOutage)
+		assert.True(t, f.day.Add(3*time.Hour).Equal(uptime.LongestOutageStartedAt))
+	})
+}
+
+func testSearchServers(t *testing.T, repo domain.Repository, f fixture) {
+	tests := []struct {
+		name          string
+		params        domain.SearchServersParams
+		expected      []string
+		expectedTotal uint64
+	}{
+		{
+			name:          "All",
+			params:        domain.SearchServersParams{},
+			expected:      []string{"a", "b", "c"},
+			expectedTotal: 3,
+		},
+		{
+			name:          "Query",
+			params:        domain.SearchServersParams{Query: "VER A"},
+			expected:      []string{"a"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "Language",
+			params:        domain.SearchServersParams{Language: "ru"},
+			expected:      []string{"b"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "Gamemode",
+			params:        domain.SearchServersParams{Gamemode: "freeroam"},
+			expected:      []string{},
+			expectedTotal: 0,
+		},
+		{
+			name:          "MinPlayers",
+			params:        domain.SearchServersParams{MinPlayers: lo.ToPtr[int32](15)},
+			expected:      []string{"a"},
+			expectedTotal: 1,
+		},
+		{
+			// offline servers have no players.
+			name:          "MaxPlayers",
+			params:        domain.SearchServersParams{MaxPlayers: lo.ToPtr[int32](20)},
+			expected:      []string{"b", "c"},
+			expectedTotal: 2,
+		},
+		{
+			name:          "Online",
+			params:        domain.SearchServersParams{Online: domain.ServerOnlineFilterOnline},
+			expected:      []string{"a", "b"},
+			expectedTotal: 2,
+		},
+		{
+			name:          "Offline",
+			params:        domain.SearchServersParams{Online: domain.ServerOnlineFilterOffline},
+			expected:      []string{"c"},
+			expectedTotal: 1,
+		},
+		{
+			// total is a count of all found servers regardless of pagination.
+			name:          "Offset",
+			params:        domain.SearchServersParams{Limit: 1, Offset: 1},
+			expected:      []string{"b"},
+			expectedTotal: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			params.Multiplayer = f.multiplayer
+
+			if params.Limit == 0 {
+				params.Limit = 10
+			}
+
+			result, err := repo.SearchServers(t.Context(), params)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedTotal, result.Total)
+			assert.Equal(t, tt.expected, summaryHosts(result.Servers))
+		})
+	}
+
+	t.Run("Facets", func(t *testing.T) {
+		result, err := repo.SearchServers(t.Context(), domain.SearchServersParams{
+			Multiplayer: f.multiplayer,
+			Limit:       1,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.ServerFacets{
+			Languages: []domain.FacetValue{{Value: "en", Count: 2}, {Value: "ru", Count: 1}},
+			Gamemodes: []domain.FacetValue{{Value: "roleplay", Count: 3}},
+		}, result.Facets)
+	})
+}
+
+func testListTrendingServers(t *testing.T, repo domain.Repository, f fixture) {
+	var (
+		a = domain.TrendingServer{Host: "a", Name: "Server a", PlayersBefore: 10, PlayersAfter: 30, PlayersChange: 20, RelativeChange: lo.ToPtr(2.0)}
+		b = domain.TrendingServer{Host: "b", Name: "Server b", PlayersBefore: 20, PlayersAfter: 10, PlayersChange: -10, RelativeChange: lo.ToPtr(-0.5)}
+		// c had no players in the previous window.
+		c = domain.TrendingServer{Host: "c", Name: "Server c", PlayersBefore: 0, PlayersAfter: 5, PlayersChange: 5}
+	)
+
+	tests := []struct {
+		name     string
+		params   domain.ListTrendingServersParams
+		expected []domain.TrendingServer
+	}{
+		{
+			name:     "Absolute",
+			params:   domain.ListTrendingServersParams{OrderBy: domain.TrendingOrderAbsolute, Limit: 10},
+			expected: []domain.TrendingServer{a, c, b},
+		},
+		{
+			name:     "Relative",
+			params:   domain.ListTrendingServersParams{OrderBy: domain.TrendingOrderRelative, Limit: 10},
+			expected: []domain.TrendingServer{a, b, c},
+		},
+		{
+			name:     "Offset",
+			params:   domain.ListTrendingServersParams{OrderBy: domain.TrendingOrderAbsolute, Limit: 1, Offset: 1},
+			expected: []domain.TrendingServer{c},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			params.Multiplayer = f.trending
+			params.Window = domain.TrendingWindowDay
+
+			servers, err := repo.ListTrendingServers(t.Context(), params)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, servers)
+		})
+	}
+}
+
+func testListServerChanges(t *testing.T, repo domain.Repository, f fixture) {
+	var (
+		gamemode = domain.ServerChange{Multiplayer: f.multiplayer, Host: "b", Field: domain.ServerFieldGamemode, OldValue: "freeroam", NewValue: "roleplay", ChangedAt: f.latestAt}
+		name     = domain.ServerChange{Multiplayer: f.multiplayer, Host: "b", Field: domain.ServerFieldName, OldValue: "Old b", NewValue: "Server b", ChangedAt: f.latestAt}
+		language = domain.ServerChange{Multiplayer: f.multiplayer, Host: "b", Field: domain.ServerFieldLanguage, OldValue: "en", NewValue: "ru", ChangedAt: f.firstAt}
+	)
+
+	tests := []struct {
+		name     string
+		limit    int32
+		offset   int32
+		expected []domain.ServerChange
+	}{
+		{
+			name:     "All",
+			limit:    10,
+			expected: []domain.ServerChange{gamemode, name, language},
+		},
+		{
+			name:     "Offset",
+			limit:    1,
+			offset:   1,
+			expected: []domain.ServerChange{name},
+		},
+		{
+			name:     "OffsetOverflow",
+			limit:    10,
+			offset:   3,
+			expected: []domain.ServerChange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := repo.ListServerChanges(t.Context(), domain.ListServerChangesParams{
+				Multiplayer: f.multiplayer,
+				Host:        "b",
+				Limit:       tt.limit,
+				Offset:      tt.offset,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, lo.Map(changes, func(change domain.ServerChange, _ int) domain.ServerChange {
+				change.ChangedAt = change.ChangedAt.UTC()
+				return change
+			}))
+		})
+	}
+}
+
+func testListMultiplayerStatistics(t *testing.T, repo domain.Repository, f fixture) {
+	tests := []struct {
+		name        string
+		multiplayer domain.Multiplayer
+		from        time.Time
+		precision   domain.ServerStatisticsPrecision
+		expected    []domain.MultiplayerStatisticPoint
+	}{
+		{
+			name:        "PerHour",
+			multiplayer: f.multiplayer,
+			from:        f.firstAt.Add(-time.Minute),
+			precision:   domain.ServerStatisticsPrecisionPerHour,
+			expected: []domain.MultiplayerStatisticPoint{
+				{PlayersCount: 40, ServersCount: 2, CollectedAt: f.latestAt},
+				{PlayersCount: 35, ServersCount: 3, CollectedAt: f.firstAt},
+			},
+		},
+		{
+			// counts are averages of snapshots of the bucket.
+			name:        "PerHourHistory",
+			multiplayer: f.history,
+			from:        f.day.Add(-time.Minute),
+			precision:   domain.ServerStatisticsPrecisionPerHour,
+			expected: []domain.MultiplayerStatisticPoint{
+				{PlayersCount: 40, ServersCount: 1, CollectedAt: f.day.Add(29 * time.Hour)},
+				{PlayersCount: 30, ServersCount: 1, CollectedAt: f.day.Add(2 * time.Hour)},
+				{PlayersCount: 15, ServersCount: 1, CollectedAt: f.day.Add(time.Hour)},
+			},
+		},
+		{
+			name:        "PerDay",
+			multiplayer: f.history,
+			from:        f.day.Add(-time.Minute),
+			precision:   domain.ServerStatisticsPrecisionPerDay,
+			expected: []domain.MultiplayerStatisticPoint{
+				{PlayersCount: 40, ServersCount: 1, CollectedAt: f.day.AddDate(0, 0, 1)},
+				{PlayersCount: 20, ServersCount: 1, CollectedAt: f.day},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statistics, err := repo.ListMultiplayerStatistics(t.Context(), domain.ListMultiplayerStatisticsParams{
+				Multiplayer: tt.multiplayer,
+				TimeRange: domain.TimeRange{
+					From: tt.from,
+					To:   time.Now(),
+				},
+				Precision: tt.precision,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, lo.Map(statistics, func(point domain.MultiplayerStatisticPoint, _ int) domain.MultiplayerStatisticPoint {
+				point.CollectedAt = point.CollectedAt.UTC()
+				return point
+			}))
+		})
+	}
+}
+
+func summaryHosts(servers []domain.ServerSummary) []string {
+	return lo.Map(servers, func(server domain.ServerSummary, _ int) string {
+		return server.Host
+	})
+}
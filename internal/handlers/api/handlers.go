@@ -6,6 +6,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/samber/lo"
@@ -43,6 +44,39 @@ func (h *Handlers) ListMultiplayerSummaries(ctx context.Context, params api.List
 	}), nil
 }
 
+// ListMultiplayerStatistics ...
+func (h *Handlers) ListMultiplayerStatistics(ctx context.Context, params api.ListMultiplayerStatisticsParams) (api.ListMultiplayerStatisticsRes, error) {
+	statistics, err := h.repo.ListMultiplayerStatistics(ctx, domain.ListMultiplayerStatisticsParams{
+		Multiplayer: domain.Multiplayer(params.MultiplayerName),
+		TimeRange: domain.TimeRange{
+			From: params.From,
+			To:   params.To,
+		},
+		Precision: precisionToDomain(params.Precision.Value),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidParams) {
+			return &api.ListMultiplayerStatisticsBadRequest{}, nil
+		}
+
+		if errors.Is(err, domain.ErrMultiplayerNotFound) {
+			return &api.ListMultiplayerStatisticsNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("h.repo.ListMultiplayerStatistics: %w", err)
+	}
+
+	resp := api.ListMultiplayerStatisticsOKApplicationJSON(lo.Map(statistics, func(point domain.MultiplayerStatisticPoint, _ int) api.MultiplayerStatisticPoint {
+		return api.MultiplayerStatisticPoint{
+			CollectedAt:  point.CollectedAt,
+			PlayersCount: point.PlayersCount,
+			ServersCount: point.ServersCount,
+		}
+	}))
+
+	return &resp, nil
+}
+
 // ListServerSummaries ...
 func (h *Handlers) ListServerSummaries(ctx context.Context, params api.ListServerSummariesParams) (api.ListServerSummariesRes, error) {
 	servers, err := h.repo.ListServerSummaries(ctx, domain.ListServerSummariesParams{
@@ -56,17 +90,117 @@ func (h *Handlers) ListServerSummaries(ctx context.Context, params api.ListServe
 		return nil, fmt.Errorf("h.repo.ListServerSummaries: %w", err)
 	}
 
-	resp := api.ListServerSummariesOKApplicationJSON(lo.Map(servers, func(server domain.ServerSummary, _ int) api.ServerSummary {
-		return api.ServerSummary{
-			Host:         server.Host,
-			Name:         server.Name,
-			PlayersCount: server.PlayersCount,
+	resp := api.ListServerSummariesOKApplicationJSON(lo.Map(servers, bindServerSummary))
+
+	return &resp, nil
+}
+
+// SearchServers ...
+func (h *Handlers) SearchServers(ctx context.Context, params api.SearchServersParams) (api.SearchServersRes, error) {
+	result, err := h.repo.SearchServers(ctx, domain.SearchServersParams{
+		Multiplayer: domain.Multiplayer(params.MultiplayerName),
+		Query:       params.Q.Value,
+		Language:    params.Language.Value,
+		Gamemode:    params.Gamemode.Value,
+		MinPlayers:  optInt32ToPtr(params.MinPlayers),
+		MaxPlayers:  optInt32ToPtr(params.MaxPlayers),
+		Online:      onlineFilterToDomain(params.Status.Value),
+		Limit:       params.Limit.Value,
+		Offset:      params.Offset.Value,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidParams) {
+			return &api.SearchServersBadRequest{}, nil
+		}
+
+		return nil, fmt.Errorf("h.repo.SearchServers: %w", err)
+	}
+
+	return &api.ServerSearchResult{
+		Servers: lo.Map(result.Servers, bindServerSummary),
+		Total:   int64(result.Total), //nolint:gosec
+		Facets: api.ServerFacets{
+			Languages: lo.Map(result.Facets.Languages, bindFacetValue),
+			Gamemodes: lo.Map(result.Facets.Gamemodes, bindFacetValue),
+		},
+	}, nil
+}
+
+// ListTrendingServers ...
+func (h *Handlers) ListTrendingServers(ctx context.Context, params api.ListTrendingServersParams) (api.ListTrendingServersRes, error) {
+	servers, err := h.repo.ListTrendingServers(ctx, domain.ListTrendingServersParams{
+		Multiplayer: domain.Multiplayer(params.MultiplayerName),
+		Window:      windowToDomain(params.Window.Value),
+		OrderBy:     trendingOrderToDomain(params.OrderBy.Value),
+		Limit:       params.Limit.Value,
+		Offset:      params.Offset.Value,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidParams) {
+			return &api.ListTrendingServersBadRequest{}, nil
+		}
+
+		return nil, fmt.Errorf("h.repo.ListTrendingServers: %w", err)
+	}
+
+	resp := api.ListTrendingServersOKApplicationJSON(lo.Map(servers, func(server domain.TrendingServer, _ int) api.TrendingServer {
+		result := api.TrendingServer{
+			Host:          server.Host,
+			Name:          server.Name,
+			PlayersBefore: server.PlayersBefore,
+			PlayersAfter:  server.PlayersAfter,
+			PlayersChange: server.PlayersChange,
 		}
+
+		if server.RelativeChange != nil {
+			result.RelativeChange = api.NewOptFloat64(*server.RelativeChange)
+		}
+
+		return result
 	}))
 
 	return &resp, nil
 }
 
+// ListServerSummariesPage ...
+func (h *Handlers) ListServerSummariesPage(ctx context.Context, params api.ListServerSummariesPageParams) (api.ListServerSummariesPageRes, error) {
+	pageParams := domain.ListServerSummariesPageParams{
+		Multiplayer:     domain.Multiplayer(params.MultiplayerName),
+		IncludeOffline:  params.IncludeOffline.Value,
+		PlayersOrderAsc: params.PlayersOrderAsc.Value,
+		Limit:           params.Limit.Value,
+	}
+
+	if params.Cursor.Set {
+		cursor, err := domain.ParseServerSummaryCursor(params.Cursor.Value)
+		if err != nil {
+			return &api.ListServerSummariesPageBadRequest{}, nil
+		}
+
+		pageParams.After = &cursor
+	}
+
+	page, err := h.repo.ListServerSummariesPage(ctx, pageParams)
+	if err != nil {
+		if errors.Is(err, domain.ErrBadCursor) {
+			return &api.ListServerSummariesPageBadRequest{}, nil
+		}
+
+		return nil, fmt.Errorf("h.repo.ListServerSummariesPage: %w", err)
+	}
+
+	result := &api.ServerSummariesPage{
+		Servers: lo.Map(page.Servers, bindServerSummary),
+		Total:   int64(page.Total), //nolint:gosec
+	}
+
+	if page.NextCursor != nil {
+		result.NextCursor = api.NewOptString(page.NextCursor.String())
+	}
+
+	return result, nil
+}
+
 // GetServer ...
 func (h *Handlers) GetServer(ctx context.Context, params api.GetServerParams) (api.GetServerRes, error) {
 	server, err := h.repo.GetServer(ctx, domain.Multiplayer(params.MultiplayerName), params.ServerHost)
@@ -78,7 +212,34 @@ func (h *Handlers) GetServer(ctx context.Context, params api.GetServerParams) (a
 		return nil, fmt.Errorf("h.repo.GetServer: %w", err)
 	}
 
-	return bindDetailedServer(server), nil
+	result := bindDetailedServer(server)
+
+	uptime, err := h.repo.GetServerUptime(ctx, domain.Multiplayer(params.MultiplayerName), params.ServerHost)
+	switch {
+	case err == nil:
+		result.Uptime = api.NewOptServerUptime(bindServerUptime(uptime))
+	// server metadata may outlive its samples, then uptime is unknown.
+	case !errors.Is(err, domain.ErrServerNotFound):
+		return nil, fmt.Errorf("h.repo.GetServerUptime: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetServerUptime ...
+func (h *Handlers) GetServerUptime(ctx context.Context, params api.GetServerUptimeParams) (api.GetServerUptimeRes, error) {
+	uptime, err := h.repo.GetServerUptime(ctx, domain.Multiplayer(params.MultiplayerName), params.ServerHost)
+	if err != nil {
+		if errors.Is(err, domain.ErrServerNotFound) {
+			return &api.GetServerUptimeNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("h.repo.GetServerUptime: %w", err)
+	}
+
+	result := bindServerUptime(uptime)
+
+	return &result, nil
 }
 
 // ListServerStatistics ...
@@ -90,9 +251,15 @@ func (h *Handlers) ListServerStatistics(ctx context.Context, params api.ListServ
 			From: params.From,
 			To:   params.To,
 		},
-		Precision: precisionToDomain(params.Precision.Value),
+		Precision:  precisionToDomain(params.Precision.Value),
+		Aggregates: lo.Map(params.Aggregates, aggregateToDomain),
+		Fill:       params.Fill.Value,
 	})
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidParams) {
+			return &api.ListServerStatisticsBadRequest{}, nil
+		}
+
 		if errors.Is(err, domain.ErrServerNotFound) {
 			return &api.ListServerStatisticsNotFound{}, nil
 		}
@@ -101,21 +268,160 @@ func (h *Handlers) ListServerStatistics(ctx context.Context, params api.ListServ
 	}
 
 	resp := api.ListServerStatisticsOKApplicationJSON(lo.Map(statistics, func(point domain.ServerStatisticPoint, _ int) api.ServerStatisticPoint {
-		return api.ServerStatisticPoint{
-			CollectedAt:  point.CollectedAt,
-			PlayersCount: point.PlayersCount,
+		return bindServerStatisticPoint(point, params.Aggregates)
+	}))
+
+	return &resp, nil
+}
+
+// ListServerHistory ...
+func (h *Handlers) ListServerHistory(ctx context.Context, params api.ListServerHistoryParams) (api.ListServerHistoryRes, error) {
+	changes, err := h.repo.ListServerChanges(ctx, domain.ListServerChangesParams{
+		Multiplayer: domain.Multiplayer(params.MultiplayerName),
+		Host:        params.ServerHost,
+		Limit:       params.Limit.Value,
+		Offset:      params.Offset.Value,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrServerNotFound) {
+			return &api.ListServerHistoryNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("h.repo.ListServerChanges: %w", err)
+	}
+
+	resp := api.ListServerHistoryOKApplicationJSON(lo.Map(changes, func(change domain.ServerChange, _ int) api.ServerChange {
+		return api.ServerChange{
+			Field:     api.ServerChangeField(change.Field),
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			ChangedAt: change.ChangedAt,
 		}
 	}))
 
 	return &resp, nil
 }
 
-func precisionToDomain(precision api.ListServerStatisticsPrecision) domain.ServerStatisticsPrecision {
-	if precision == api.ListServerStatisticsPrecisionPerDay {
+func precisionToDomain(precision api.StatisticsPrecision) domain.ServerStatisticsPrecision {
+	switch precision {
+	case api.StatisticsPrecisionPer5Minutes:
+		return domain.ServerStatisticsPrecisionPerFiveMinutes
+	case api.StatisticsPrecisionPer15Minutes:
+		return domain.ServerStatisticsPrecisionPerFifteenMinutes
+	case api.StatisticsPrecisionPerDay:
 		return domain.ServerStatisticsPrecisionPerDay
+	case api.StatisticsPrecisionPerWeek:
+		return domain.ServerStatisticsPrecisionPerWeek
+	case api.StatisticsPrecisionPerMonth:
+		return domain.ServerStatisticsPrecisionPerMonth
+	default:
+		return domain.ServerStatisticsPrecisionPerHour
+	}
+}
+
+func optInt32ToPtr(value api.OptInt32) *int32 {
+	if !value.Set {
+		return nil
+	}
+
+	return &value.Value
+}
+
+func onlineFilterToDomain(status api.SearchServersStatus) domain.ServerOnlineFilter {
+	switch status {
+	case api.SearchServersStatusOnline:
+		return domain.ServerOnlineFilterOnline
+	case api.SearchServersStatusOffline:
+		return domain.ServerOnlineFilterOffline
+	default:
+		return domain.ServerOnlineFilterAny
+	}
+}
+
+func bindServerSummary(server domain.ServerSummary, _ int) api.ServerSummary {
+	return api.ServerSummary{
+		Host:         server.Host,
+		Name:         server.Name,
+		PlayersCount: server.PlayersCount,
+		Online:       server.Online,
+		LastSeenAt:   server.LastSeenAt,
+	}
+}
+
+func bindFacetValue(value domain.FacetValue, _ int) api.FacetValue {
+	return api.FacetValue{
+		Value: value.Value,
+		Count: int64(value.Count), //nolint:gosec
+	}
+}
+
+func windowToDomain(window api.ListTrendingServersWindow) domain.TrendingWindow {
+	switch window {
+	case api.ListTrendingServersWindow7d:
+		return domain.TrendingWindowWeek
+	case api.ListTrendingServersWindow30d:
+		return domain.TrendingWindowMonth
+	default:
+		return domain.TrendingWindowDay
 	}
+}
+
+func trendingOrderToDomain(order api.ListTrendingServersOrderBy) domain.TrendingOrder {
+	if order == api.ListTrendingServersOrderByRelative {
+		return domain.TrendingOrderRelative
+	}
+
+	return domain.TrendingOrderAbsolute
+}
 
-	return domain.ServerStatisticsPrecisionPerHour
+func aggregateToDomain(aggregate api.ListServerStatisticsAggregatesItem, _ int) domain.ServerStatisticAggregate {
+	switch aggregate {
+	case api.ListServerStatisticsAggregatesItemMin:
+		return domain.ServerStatisticAggregateMin
+	case api.ListServerStatisticsAggregatesItemMax:
+		return domain.ServerStatisticAggregateMax
+	case api.ListServerStatisticsAggregatesItemP50:
+		return domain.ServerStatisticAggregateP50
+	case api.ListServerStatisticsAggregatesItemP95:
+		return domain.ServerStatisticAggregateP95
+	case api.ListServerStatisticsAggregatesItemCount:
+		return domain.ServerStatisticAggregateCount
+	default:
+		return domain.ServerStatisticAggregateAvg
+	}
+}
+
+func bindServerStatisticPoint(point domain.ServerStatisticPoint, aggregates []api.ListServerStatisticsAggregatesItem) api.ServerStatisticPoint {
+	result := api.ServerStatisticPoint{
+		CollectedAt: point.CollectedAt,
+		Status:      api.ServerStatisticPointStatus(point.Status),
+	}
+
+	// players of not online server are unknown, so aggregates are not set too.
+	if point.Status != domain.ServerStatisticStatusOnline {
+		result.PlayersCount.SetToNull()
+		return result
+	}
+
+	result.PlayersCount = api.NewNilInt32(point.PlayersCount)
+
+	for _, aggregate := range aggregates {
+		switch aggregate {
+		case api.ListServerStatisticsAggregatesItemMin:
+			result.PlayersMin = api.NewOptInt32(point.PlayersMin)
+		case api.ListServerStatisticsAggregatesItemMax:
+			result.PlayersMax = api.NewOptInt32(point.PlayersMax)
+		case api.ListServerStatisticsAggregatesItemP50:
+			result.PlayersP50 = api.NewOptInt32(point.PlayersP50)
+		case api.ListServerStatisticsAggregatesItemP95:
+			result.PlayersP95 = api.NewOptInt32(point.PlayersP95)
+		case api.ListServerStatisticsAggregatesItemCount:
+			result.Samples = api.NewOptInt64(int64(point.Samples)) //nolint:gosec
+		case api.ListServerStatisticsAggregatesItemAvg:
+		}
+	}
+
+	return result
 }
 
 func bindDetailedServer(server domain.Server) *api.DetailedServer {
@@ -139,5 +445,51 @@ func bindDetailedServer(server domain.Server) *api.DetailedServer {
 		result.PlayersCount = api.NewOptInt64(int64(server.PlayersCount))
 	}
 
+	if server.MaxPlayers > 0 {
+		result.MaxPlayers = api.NewOptInt32(server.MaxPlayers)
+	}
+
+	if server.Version != "" {
+		result.Version = api.NewOptString(server.Version)
+	}
+
+	if server.PeakPlayers > 0 {
+		result.PeakPlayers = api.NewOptInt32(server.PeakPlayers)
+	}
+
+	if server.Map != "" {
+		result.Map = api.NewOptString(server.Map)
+	}
+
+	if server.Passworded != nil {
+		result.Passworded = api.NewOptBool(*server.Passworded)
+	}
+	result.Tags = server.Tags
+
 	return result
 }
+
+func bindServerUptime(uptime domain.ServerUptime) api.ServerUptime {
+	result := api.ServerUptime{
+		FirstSeenAt:          uptime.FirstSeenAt,
+		LastSeenAt:           uptime.LastSeenAt,
+		Availability24h:      ptrToOptNilFloat64(uptime.Availability24h),
+		Availability7d:       ptrToOptNilFloat64(uptime.Availability7d),
+		Availability30d:      ptrToOptNilFloat64(uptime.Availability30d),
+		LongestOutageSeconds: int64(uptime.LongestOutage / time.Second),
+	}
+
+	if uptime.LongestOutage > 0 {
+		result.LongestOutageStartedAt = api.NewOptDateTime(uptime.LongestOutageStartedAt)
+	}
+
+	return result
+}
+
+func ptrToOptNilFloat64(value *float64) api.OptNilFloat64 {
+	if value == nil {
+		return api.OptNilFloat64{Set: true, Null: true}
+	}
+
+	return api.NewOptNilFloat64(*value)
+}
@@ -0,0 +1,610 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package api
+
+import (
+	"database/sql"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum"
+	sqliteAdapter "github.com/EpicStep/gdatum/internal/adapters/sqlite"
+	"github.com/EpicStep/gdatum/internal/config"
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/infrastructure/repository/sqlite"
+	"github.com/EpicStep/gdatum/internal/utils/migrations"
+	"github.com/EpicStep/gdatum/pkg/api"
+)
+
+const testMultiplayer = "samp"
+
+// fixture is a multiplayer with two snapshots, server "b" is missing from the latest one.
+type fixture struct {
+	// firstAt is in the previous trending day window, latestAt is in the current one.
+	firstAt  time.Time
+	latestAt time.Time
+}
+
+// newClient returns client of the API server backed by SQLite with fixture data.
+func newClient(t *testing.T) (*api.Client, fixture) {
+	t.Helper()
+
+	dsn := filepath.Join(t.TempDir(), "gdatum.db")
+
+	require.NoError(t, migrations.Run(t.Context(), config.DatabaseDriverSQLite, dsn, gdatum.MigrationsFS))
+
+	db, err := sql.Open("sqlite", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	db.SetMaxOpenConns(1)
+
+	repo := sqliteAdapter.New(sqlite.New(db))
+
+	f := fixture{
+		latestAt: time.Now().UTC().Truncate(time.Hour),
+	}
+	f.firstAt = f.latestAt.Add(-25 * time.Hour)
+
+	server := func(host string, playersCount int32, collectedAt time.Time) domain.Server {
+		return domain.Server{
+			Multiplayer:  testMultiplayer,
+			Host:         host,
+			Name:         "Server " + host,
+			Gamemode:     "roleplay",
+			Language:     "en",
+			PlayersCount: playersCount,
+			MaxPlayers:   100,
+			CollectedAt:  collectedAt,
+		}
+	}
+
+	snapshots := []domain.Snapshot{
+		domain.NewSnapshot(testMultiplayer, f.firstAt, []domain.Server{
+			server("a", 10, f.firstAt),
+			server("b", 20, f.firstAt),
+		}),
+		domain.NewSnapshot(testMultiplayer, f.latestAt, []domain.Server{
+			server("a", 30, f.latestAt),
+		}),
+	}
+
+	for _, snapshot := range snapshots {
+		require.NoError(t, repo.InsertSnapshot(t.Context(), snapshot))
+		require.NoError(t, repo.InsertCollectionRun(t.Context(), domain.CollectionRun{
+			Multiplayer:  testMultiplayer,
+			CollectedAt:  snapshot.CollectedAt,
+			Status:       domain.CollectionRunStatusOK,
+			ServersCount: int32(len(snapshot.Servers)), //nolint:gosec
+		}))
+	}
+
+	require.NoError(t, repo.InsertServerChanges(t.Context(), []domain.ServerChange{
+		{
+			Multiplayer: testMultiplayer,
+			Host:        "a",
+			Field:       domain.ServerFieldName,
+			OldValue:    "Old a",
+			NewValue:    "Server a",
+			ChangedAt:   f.latestAt,
+		},
+	}))
+
+	apiServer, err := api.NewServer(New(repo))
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(apiServer)
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(srv.URL)
+	require.NoError(t, err)
+
+	return client, f
+}
+
+func TestHandlers_ListServerHistory(t *testing.T) {
+	t.Parallel()
+
+	client, f := newClient(t)
+
+	res, err := client.ListServerHistory(t.Context(), api.ListServerHistoryParams{
+		MultiplayerName: testMultiplayer,
+		ServerHost:      "a",
+	})
+	require.NoError(t, err)
+
+	changes, ok := res.(*api.ListServerHistoryOKApplicationJSON)
+	require.True(t, ok)
+	require.Len(t, *changes, 1)
+
+	change := (*changes)[0]
+	assert.Equal(t, api.ServerChangeFieldName, change.Field)
+	assert.Equal(t, "Old a", change.OldValue)
+	assert.Equal(t, "Server a", change.NewValue)
+	assert.True(t, f.latestAt.Equal(change.ChangedAt))
+
+	res, err = client.ListServerHistory(t.Context(), api.ListServerHistoryParams{
+		MultiplayerName: testMultiplayer,
+		ServerHost:      "unknown",
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &api.ListServerHistoryNotFound{}, res)
+}
+
+func TestHandlers_ListServerStatistics(t *testing.T) {
+	t.Parallel()
+
+	client, f := newClient(t)
+
+	type point struct {
+		collectedAt  time.Time
+		status       api.ServerStatisticPointStatus
+		playersCount api.NilInt32
+		samples      api.OptInt64
+	}
+
+	tests := []struct {
+		name     string
+		params   api.ListServerStatisticsParams
+		expected []point
+	}{
+		{
+			name: "Aggregates",
+			params: api.ListServerStatisticsParams{
+				From:       f.firstAt.Add(-time.Minute),
+				To:         time.Now(),
+				Aggregates: []api.ListServerStatisticsAggregatesItem{api.ListServerStatisticsAggregatesItemCount},
+			},
+			expected: []point{
+				{collectedAt: f.latestAt, status: api.ServerStatisticPointStatusOnline, playersCount: api.NewNilInt32(30), samples: api.NewOptInt64(1)},
+				{collectedAt: f.firstAt, status: api.ServerStatisticPointStatusOnline, playersCount: api.NewNilInt32(10), samples: api.NewOptInt64(1)},
+			},
+		},
+		{
+			// players of not online bucket are null.
+			name: "Fill",
+			params: api.ListServerStatisticsParams{
+				From: f.firstAt.Add(-time.Minute),
+				To:   f.firstAt.Add(2 * time.Hour),
+				Fill: api.NewOptBool(true),
+			},
+			expected: []point{
+				{collectedAt: f.firstAt.Add(time.Hour), status: api.ServerStatisticPointStatusUnknown, playersCount: api.NilInt32{Null: true}},
+				{collectedAt: f.firstAt, status: api.ServerStatisticPointStatusOnline, playersCount: api.NewNilInt32(10)},
+				{collectedAt: f.firstAt.Add(-time.Hour), status: api.ServerStatisticPointStatusUnknown, playersCount: api.NilInt32{Null: true}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := tt.params
+			params.MultiplayerName = testMultiplayer
+			params.ServerHost = "a"
+
+			res, err := client.ListServerStatistics(t.Context(), params)
+			require.NoError(t, err)
+
+			statistics, ok := res.(*api.ListServerStatisticsOKApplicationJSON)
+			require.True(t, ok)
+
+			assert.Equal(t, tt.expected, lo.Map(*statistics, func(statistic api.ServerStatisticPoint, _ int) point {
+				return point{
+					collectedAt:  statistic.CollectedAt.UTC(),
+					status:       statistic.Status,
+					playersCount: statistic.PlayersCount,
+					samples:      statistic.Samples,
+				}
+			}))
+		})
+	}
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.ListServerStatistics(t.Context(), api.ListServerStatisticsParams{
+			MultiplayerName: testMultiplayer,
+			ServerHost:      "unknown",
+			From:            f.firstAt,
+			To:              time.Now(),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ListServerStatisticsNotFound{}, res)
+	})
+
+	badRequestTests := []struct {
+		name   string
+		params api.ListServerStatisticsParams
+	}{
+		{
+			name:   "ReversedTimeRange",
+			params: api.ListServerStatisticsParams{From: f.latestAt, To: f.firstAt},
+		},
+		{
+			name: "TimeRangeTooLongForPrecision",
+			params: api.ListServerStatisticsParams{
+				From:      f.latestAt.AddDate(0, -2, 0),
+				To:        f.latestAt,
+				Precision: api.NewOptStatisticsPrecision(api.StatisticsPrecisionPerHour),
+			},
+		},
+	}
+
+	for _, tt := range badRequestTests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := tt.params
+			params.MultiplayerName = testMultiplayer
+			params.ServerHost = "a"
+
+			res, err := client.ListServerStatistics(t.Context(), params)
+			require.NoError(t, err)
+			assert.IsType(t, &api.ListServerStatisticsBadRequest{}, res)
+		})
+	}
+}
+
+func TestHandlers_ListMultiplayerStatistics(t *testing.T) {
+	t.Parallel()
+
+	client, f := newClient(t)
+
+	res, err := client.ListMultiplayerStatistics(t.Context(), api.ListMultiplayerStatisticsParams{
+		MultiplayerName: testMultiplayer,
+		From:            f.firstAt.Add(-time.Minute),
+		To:              time.Now(),
+	})
+	require.NoError(t, err)
+
+	statistics, ok := res.(*api.ListMultiplayerStatisticsOKApplicationJSON)
+	require.True(t, ok)
+	require.Len(t, *statistics, 2)
+
+	assert.True(t, f.latestAt.Equal((*statistics)[0].CollectedAt))
+	assert.Equal(t, int64(30), (*statistics)[0].PlayersCount)
+	assert.Equal(t, int64(1), (*statistics)[0].ServersCount)
+
+	assert.True(t, f.firstAt.Equal((*statistics)[1].CollectedAt))
+	assert.Equal(t, int64(30), (*statistics)[1].PlayersCount)
+	assert.Equal(t, int64(2), (*statistics)[1].ServersCount)
+
+	res, err = client.ListMultiplayerStatistics(t.Context(), api.ListMultiplayerStatisticsParams{
+		MultiplayerName: "unknown",
+		From:            f.firstAt,
+		To:              time.Now(),
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &api.ListMultiplayerStatisticsNotFound{}, res)
+
+	res, err = client.ListMultiplayerStatistics(t.Context(), api.ListMultiplayerStatisticsParams{
+		MultiplayerName: testMultiplayer,
+		From:            f.latestAt,
+		To:              f.firstAt,
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &api.ListMultiplayerStatisticsBadRequest{}, res)
+}
+
+func TestHandlers_SearchServers(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newClient(t)
+
+	tests := []struct {
+		name          string
+		params        api.SearchServersParams
+		expected      []string
+		expectedTotal int64
+	}{
+		{
+			name:          "All",
+			expected:      []string{"a", "b"},
+			expectedTotal: 2,
+		},
+		{
+			name:          "Query",
+			params:        api.SearchServersParams{Q: api.NewOptString("server A")},
+			expected:      []string{"a"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "Offline",
+			params:        api.SearchServersParams{Status: api.NewOptSearchServersStatus(api.SearchServersStatusOffline)},
+			expected:      []string{"b"},
+			expectedTotal: 1,
+		},
+		{
+			name:          "MinPlayers",
+			params:        api.SearchServersParams{MinPlayers: api.NewOptInt32(40)},
+			expected:      []string{},
+			expectedTotal: 0,
+		},
+		{
+			name:          "Limit",
+			params:        api.SearchServersParams{Limit: api.NewOptInt32(1)},
+			expected:      []string{"a"},
+			expectedTotal: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := tt.params
+			params.MultiplayerName = testMultiplayer
+
+			res, err := client.SearchServers(t.Context(), params)
+			require.NoError(t, err)
+
+			result, ok := res.(*api.ServerSearchResult)
+			require.True(t, ok)
+
+			assert.Equal(t, tt.expectedTotal, result.Total)
+			assert.Equal(t, tt.expected, summaryHosts(result.Servers))
+		})
+	}
+
+	t.Run("Facets", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.SearchServers(t.Context(), api.SearchServersParams{MultiplayerName: testMultiplayer})
+		require.NoError(t, err)
+
+		result, ok := res.(*api.ServerSearchResult)
+		require.True(t, ok)
+
+		assert.Equal(t, api.ServerFacets{
+			Languages: []api.FacetValue{{Value: "en", Count: 2}},
+			Gamemodes: []api.FacetValue{{Value: "roleplay", Count: 2}},
+		}, result.Facets)
+	})
+
+	badRequestTests := []struct {
+		name   string
+		params api.SearchServersParams
+	}{
+		{
+			name:   "ReversedPlayersRange",
+			params: api.SearchServersParams{MinPlayers: api.NewOptInt32(20), MaxPlayers: api.NewOptInt32(10)},
+		},
+		{
+			name:   "TooLongQuery",
+			params: api.SearchServersParams{Q: api.NewOptString(strings.Repeat("a", 129))},
+		},
+	}
+
+	for _, tt := range badRequestTests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := tt.params
+			params.MultiplayerName = testMultiplayer
+
+			res, err := client.SearchServers(t.Context(), params)
+			require.NoError(t, err)
+			assert.IsType(t, &api.SearchServersBadRequest{}, res)
+		})
+	}
+}
+
+func TestHandlers_ListServerSummariesPage(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newClient(t)
+
+	params := api.ListServerSummariesPageParams{
+		MultiplayerName: testMultiplayer,
+		Limit:           api.NewOptInt32(1),
+		IncludeOffline:  api.NewOptBool(true),
+	}
+
+	res, err := client.ListServerSummariesPage(t.Context(), params)
+	require.NoError(t, err)
+
+	page, ok := res.(*api.ServerSummariesPage)
+	require.True(t, ok)
+	assert.Equal(t, int64(2), page.Total)
+	assert.Equal(t, []string{"a"}, summaryHosts(page.Servers))
+	require.True(t, page.NextCursor.Set)
+
+	cursor := page.NextCursor
+
+	t.Run("NextPage", func(t *testing.T) {
+		t.Parallel()
+
+		params := params
+		params.Cursor = cursor
+
+		res, err := client.ListServerSummariesPage(t.Context(), params)
+		require.NoError(t, err)
+
+		page, ok := res.(*api.ServerSummariesPage)
+		require.True(t, ok)
+		assert.Equal(t, []string{"b"}, summaryHosts(page.Servers))
+		assert.False(t, page.NextCursor.Set)
+	})
+
+	tests := []struct {
+		name   string
+		params api.ListServerSummariesPageParams
+	}{
+		{
+			name: "MalformedCursor",
+			params: api.ListServerSummariesPageParams{
+				MultiplayerName: testMultiplayer,
+				Cursor:          api.NewOptString("malformed"),
+			},
+		},
+		{
+			name: "CursorOfOtherOrder",
+			params: api.ListServerSummariesPageParams{
+				MultiplayerName: testMultiplayer,
+				PlayersOrderAsc: api.NewOptBool(true),
+				IncludeOffline:  api.NewOptBool(true),
+				Cursor:          cursor,
+			},
+		},
+		{
+			name: "CursorOfOtherOfflineFilter",
+			params: api.ListServerSummariesPageParams{
+				MultiplayerName: testMultiplayer,
+				Cursor:          cursor,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := client.ListServerSummariesPage(t.Context(), tt.params)
+			require.NoError(t, err)
+			assert.IsType(t, &api.ListServerSummariesPageBadRequest{}, res)
+		})
+	}
+}
+
+func TestHandlers_ListTrendingServers(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newClient(t)
+
+	var (
+		a = api.TrendingServer{Host: "a", Name: "Server a", PlayersBefore: 10, PlayersAfter: 30, PlayersChange: 20, RelativeChange: api.NewOptFloat64(2)}
+		b = api.TrendingServer{Host: "b", Name: "Server b", PlayersBefore: 20, PlayersAfter: 0, PlayersChange: -20, RelativeChange: api.NewOptFloat64(-1)}
+	)
+
+	tests := []struct {
+		name     string
+		params   api.ListTrendingServersParams
+		expected []api.TrendingServer
+	}{
+		{
+			name:     "Default",
+			expected: []api.TrendingServer{a, b},
+		},
+		{
+			name:     "Relative",
+			params:   api.ListTrendingServersParams{OrderBy: api.NewOptListTrendingServersOrderBy(api.ListTrendingServersOrderByRelative)},
+			expected: []api.TrendingServer{a, b},
+		},
+		{
+			// both snapshots are in the current week window.
+			name:   "Week",
+			params: api.ListTrendingServersParams{Window: api.NewOptListTrendingServersWindow(api.ListTrendingServersWindow7d)},
+			expected: []api.TrendingServer{
+				{Host: "a", Name: "Server a", PlayersAfter: 20, PlayersChange: 20},
+				{Host: "b", Name: "Server b", PlayersAfter: 20, PlayersChange: 20},
+			},
+		},
+		{
+			name:     "Offset",
+			params:   api.ListTrendingServersParams{Offset: api.NewOptInt32(1)},
+			expected: []api.TrendingServer{b},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := tt.params
+			params.MultiplayerName = testMultiplayer
+
+			res, err := client.ListTrendingServers(t.Context(), params)
+			require.NoError(t, err)
+
+			servers, ok := res.(*api.ListTrendingServersOKApplicationJSON)
+			require.True(t, ok)
+
+			assert.Equal(t, tt.expected, []api.TrendingServer(*servers))
+		})
+	}
+
+	t.Run("UnknownWindow", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.ListTrendingServers(t.Context(), api.ListTrendingServersParams{
+			MultiplayerName: testMultiplayer,
+			Window:          api.NewOptListTrendingServersWindow("1y"),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ListTrendingServersBadRequest{}, res)
+	})
+}
+
+func TestHandlers_GetServerUptime(t *testing.T) {
+	t.Parallel()
+
+	client, f := newClient(t)
+
+	t.Run("Online", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.GetServerUptime(t.Context(), api.GetServerUptimeParams{
+			MultiplayerName: testMultiplayer,
+			ServerHost:      "a",
+		})
+		require.NoError(t, err)
+
+		uptime, ok := res.(*api.ServerUptime)
+		require.True(t, ok)
+
+		assert.True(t, f.firstAt.Equal(uptime.FirstSeenAt))
+		assert.True(t, f.latestAt.Equal(uptime.LastSeenAt))
+		assert.Equal(t, api.NewOptNilFloat64(100), uptime.Availability24h)
+		assert.Equal(t, api.NewOptNilFloat64(100), uptime.Availability7d)
+		assert.Zero(t, uptime.LongestOutageSeconds)
+		assert.False(t, uptime.LongestOutageStartedAt.Set)
+	})
+
+	t.Run("OngoingOutage", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.GetServerUptime(t.Context(), api.GetServerUptimeParams{
+			MultiplayerName: testMultiplayer,
+			ServerHost:      "b",
+		})
+		require.NoError(t, err)
+
+		uptime, ok := res.(*api.ServerUptime)
+		require.True(t, ok)
+
+		assert.True(t, f.firstAt.Equal(uptime.LastSeenAt))
+		assert.Equal(t, api.NewOptNilFloat64(0), uptime.Availability24h)
+		assert.Equal(t, api.NewOptNilFloat64(50), uptime.Availability7d)
+		require.True(t, uptime.LongestOutageStartedAt.Set)
+		assert.True(t, f.latestAt.Equal(uptime.LongestOutageStartedAt.Value))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.GetServerUptime(t.Context(), api.GetServerUptimeParams{
+			MultiplayerName: testMultiplayer,
+			ServerHost:      "unknown",
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.GetServerUptimeNotFound{}, res)
+	})
+}
+
+func summaryHosts(servers []api.ServerSummary) []string {
+	return lo.Map(servers, func(server api.ServerSummary, _ int) string {
+		return server.Host
+	})
+}
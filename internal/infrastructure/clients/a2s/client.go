@@ -0,0 +1,199 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package a2s
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	defaultQueryTimeout = 3 * time.Second
+
+	// maxChallengeAttempts limits how many times server can reply with challenge to a single query.
+	maxChallengeAttempts = 3
+)
+
+var (
+	errTooManyChallenges = errors.New("too many challenge responses")
+	// noChallenge is a challenge, that is sent to obtain real challenge from server.
+	noChallenge = []byte{0xff, 0xff, 0xff, 0xff}
+)
+
+// Client is a Valve A2S query protocol client.
+type Client struct {
+	queryTimeout time.Duration
+}
+
+// NewOpts ...
+type NewOpts struct {
+	// QueryTimeout is a timeout of the whole single query, including challenge round trips.
+	QueryTimeout time.Duration
+}
+
+func (o *NewOpts) setDefaults() {
+	if o.QueryTimeout <= 0 {
+		o.QueryTimeout = defaultQueryTimeout
+	}
+}
+
+// New returns new Client.
+func New(opts NewOpts) *Client {
+	opts.setDefaults()
+
+	return &Client{
+		queryTimeout: opts.QueryTimeout,
+	}
+}
+
+// Info returns server info by A2S_INFO query.
+func (c *Client) Info(ctx context.Context, address string) (Info, error) {
+	payload, err := c.query(ctx, address, headerInfo, nil)
+	if err != nil {
+		return Info{}, fmt.Errorf("c.query: %w", err)
+	}
+
+	info, err := decodeInfo(payload)
+	if err != nil {
+		return Info{}, fmt.Errorf("decodeInfo: %w", err)
+	}
+
+	return info, nil
+}
+
+// Players returns server players by A2S_PLAYER query.
+func (c *Client) Players(ctx context.Context, address string) ([]Player, error) {
+	payload, err := c.query(ctx, address, headerPlayer, noChallenge)
+	if err != nil {
+		return nil, fmt.Errorf("c.query: %w", err)
+	}
+
+	players, err := decodePlayers(payload)
+	if err != nil {
+		return nil, fmt.Errorf("decodePlayers: %w", err)
+	}
+
+	return players, nil
+}
+
+// Rules returns server rules by A2S_RULES query.
+func (c *Client) Rules(ctx context.Context, address string) (Rules, error) {
+	payload, err := c.query(ctx, address, headerRules, noChallenge)
+	if err != nil {
+		return nil, fmt.Errorf("c.query: %w", err)
+	}
+
+	rules, err := decodeRules(payload)
+	if err != nil {
+		return nil, fmt.Errorf("decodeRules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// query sends request and returns response payload without header, handling challenges and split packets.
+func (c *Client) query(ctx context.Context, address string, header byte, challenge []byte) ([]byte, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("dialer.DialContext: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	deadline := time.Now().Add(c.queryTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	if err = conn.SetDeadline(deadline); err != nil {
+		return nil, fmt.Errorf("conn.SetDeadline: %w", err)
+	}
+
+	for range maxChallengeAttempts {
+		if _, err = conn.Write(buildRequest(header, challenge)); err != nil {
+			return nil, fmt.Errorf("conn.Write: %w", err)
+		}
+
+		payload, err := readResponse(conn)
+		if err != nil {
+			return nil, fmt.Errorf("readResponse: %w", err)
+		}
+
+		if len(payload) == 0 || payload[0] != headerChallenge {
+			return payload, nil
+		}
+
+		challenge = payload[1:]
+	}
+
+	return nil, errTooManyChallenges
+}
+
+// readResponse reads a single or split response from connection.
+func readResponse(conn net.Conn) ([]byte, error) {
+	buf := make([]byte, maxPacketSize)
+
+	var (
+		packets  []splitPacket
+		received int
+	)
+
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, fmt.Errorf("conn.Read: %w", err)
+		}
+
+		if n < 4 {
+			return nil, errMalformedPacket
+		}
+
+		switch int32(binary.LittleEndian.Uint32(buf)) { //nolint:gosec
+		case simpleHeader:
+			return append([]byte(nil), buf[4:n]...), nil
+		case splitHeader:
+			packet, err := parseSplitPacket(append([]byte(nil), buf[4:n]...))
+			if err != nil {
+				return nil, err
+			}
+
+			if packets == nil {
+				packets = make([]splitPacket, packet.total)
+			}
+
+			if packet.total != len(packets) {
+				return nil, errMalformedPacket
+			}
+
+			if packets[packet.number].payload == nil {
+				received++
+			}
+
+			packets[packet.number] = packet
+
+			if received < len(packets) {
+				continue
+			}
+
+			payload, err := assembleSplitPackets(packets)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(payload) < 4 || int32(binary.LittleEndian.Uint32(payload)) != simpleHeader { //nolint:gosec
+				return nil, errMalformedPacket
+			}
+
+			return payload[4:], nil
+		default:
+			return nil, errMalformedPacket
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package a2s
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testChallenge = []byte{0x11, 0x22, 0x33, 0x44}
+
+// startFakeServer starts UDP server, that requires challenge for every query and splits rules response.
+func startFakeServer(t *testing.T) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close() //nolint:errcheck
+	})
+
+	info := []byte{responseInfo, 17}
+	info = append(info, "Garry's Mod DarkRP\x00gm_construct\x00garrysmod\x00DarkRP\x00"...)
+	info = binary.LittleEndian.AppendUint16(info, 4000)
+	info = append(info, 42, 128, 2, 'd', 'l', 0, 1)
+	info = append(info, "2024.10.29\x00"...)
+	info = append(info, edfPort|edfKeywords)
+	info = binary.LittleEndian.AppendUint16(info, 27015)
+	info = append(info, "gm:darkrp,roleplay\x00"...)
+
+	players := []byte{responsePlayer, 1, 0}
+	players = append(players, "Gordon\x00"...)
+	players = binary.LittleEndian.AppendUint32(players, 15)
+	players = binary.LittleEndian.AppendUint32(players, math.Float32bits(90))
+
+	rules := []byte{responseRules}
+	rules = binary.LittleEndian.AppendUint16(rules, 2)
+	rules = append(rules, "sv_gravity\x00600\x00hostname\x00Garry's Mod DarkRP\x00"...)
+
+	go func() {
+		buf := make([]byte, maxPacketSize)
+
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			request := buf[:n]
+			if !bytes.HasSuffix(request, testChallenge) {
+				challenge := append(binary.LittleEndian.AppendUint32(nil, math.MaxUint32), headerChallenge)
+				_, _ = conn.WriteTo(append(challenge, testChallenge...), addr) //nolint:errcheck
+				continue
+			}
+
+			switch request[4] {
+			case headerInfo:
+				writeSimple(conn, addr, info)
+			case headerPlayer:
+				writeSimple(conn, addr, players)
+			case headerRules:
+				writeSplit(conn, addr, rules)
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func writeSimple(conn net.PacketConn, addr net.Addr, payload []byte) {
+	packet := binary.LittleEndian.AppendUint32(nil, math.MaxUint32)
+	_, _ = conn.WriteTo(append(packet, payload...), addr) //nolint:errcheck
+}
+
+// writeSplit sends payload in two split packets in reverse order.
+func writeSplit(conn net.PacketConn, addr net.Addr, payload []byte) {
+	payload = append(binary.LittleEndian.AppendUint32(nil, math.MaxUint32), payload...)
+	parts := [][]byte{payload[:10], payload[10:]}
+
+	for i := len(parts) - 1; i >= 0; i-- {
+		packet := binary.LittleEndian.AppendUint32(nil, math.MaxUint32-1) // -2
+		packet = binary.LittleEndian.AppendUint32(packet, 7)
+		packet = append(packet, byte(len(parts)), byte(i))
+		packet = binary.LittleEndian.AppendUint16(packet, maxPacketSize)
+		_, _ = conn.WriteTo(append(packet, parts[i]...), addr) //nolint:errcheck
+	}
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	address := startFakeServer(t)
+	client := New(NewOpts{})
+
+	t.Run("Info", func(t *testing.T) {
+		t.Parallel()
+
+		info, err := client.Info(t.Context(), address)
+		require.NoError(t, err)
+
+		assert.Equal(t, Info{
+			Protocol:   17,
+			Name:       "Garry's Mod DarkRP",
+			Map:        "gm_construct",
+			Folder:     "garrysmod",
+			Game:       "DarkRP",
+			AppID:      4000,
+			Players:    42,
+			MaxPlayers: 128,
+			Bots:       2,
+			ServerType: 'd',
+			OS:         'l',
+			VAC:        true,
+			Version:    "2024.10.29",
+			Keywords:   "gm:darkrp,roleplay",
+		}, info)
+	})
+
+	t.Run("Players", func(t *testing.T) {
+		t.Parallel()
+
+		players, err := client.Players(t.Context(), address)
+		require.NoError(t, err)
+
+		assert.Equal(t, []Player{{Name: "Gordon", Score: 15, Duration: 90 * time.Second}}, players)
+	})
+
+	t.Run("Rules", func(t *testing.T) {
+		t.Parallel()
+
+		rules, err := client.Rules(t.Context(), address)
+		require.NoError(t, err)
+
+		assert.Equal(t, Rules{"sv_gravity": "600", "hostname": "Garry's Mod DarkRP"}, rules)
+	})
+}
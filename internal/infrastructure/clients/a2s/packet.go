@@ -0,0 +1,318 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package a2s
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"time"
+)
+
+const (
+	simpleHeader = -1
+	splitHeader  = -2
+
+	// compressedFlag is set in split packet ID, when payload is compressed by bzip2.
+	compressedFlag = 0x80000000
+
+	headerInfo      = 'T'
+	headerPlayer    = 'U'
+	headerRules     = 'V'
+	headerChallenge = 'A'
+
+	responseInfo   = 'I'
+	responsePlayer = 'D'
+	responseRules  = 'E'
+
+	infoPayload = "Source Engine Query\x00"
+
+	// Extra data flags of A2S_INFO response.
+	edfPort     = 0x80
+	edfSteamID  = 0x10
+	edfSourceTV = 0x40
+	edfKeywords = 0x20
+
+	maxPacketSize = 1400
+	maxSplitCount = 64
+)
+
+var (
+	errMalformedPacket    = errors.New("malformed packet")
+	errUnexpectedResponse = errors.New("unexpected response type")
+	errChecksumMismatch   = errors.New("decompressed payload checksum mismatch")
+)
+
+// buildRequest returns request packet with provided header and challenge.
+func buildRequest(header byte, challenge []byte) []byte {
+	packet := binary.LittleEndian.AppendUint32(nil, math.MaxUint32) // -1
+	packet = append(packet, header)
+
+	if header == headerInfo {
+		packet = append(packet, infoPayload...)
+	}
+
+	return append(packet, challenge...)
+}
+
+// splitPacket is a single part of multi-packet response.
+type splitPacket struct {
+	id      uint32
+	total   int
+	number  int
+	payload []byte
+}
+
+// parseSplitPacket parses Source engine split packet header (without -2 prefix).
+func parseSplitPacket(data []byte) (splitPacket, error) {
+	r := newReader(data)
+
+	packet := splitPacket{
+		id:     r.uint32(),
+		total:  int(r.uint8()),
+		number: int(r.uint8()),
+	}
+	_ = r.uint16() // max packet size
+
+	if r.err != nil || packet.total == 0 || packet.total > maxSplitCount || packet.number >= packet.total {
+		return splitPacket{}, errMalformedPacket
+	}
+
+	packet.payload = r.rest()
+
+	return packet, nil
+}
+
+// assembleSplitPackets joins ordered split packets into a single payload, decompressing it when needed.
+func assembleSplitPackets(packets []splitPacket) ([]byte, error) {
+	var payload []byte
+	for _, packet := range packets {
+		payload = append(payload, packet.payload...)
+	}
+
+	if packets[0].id&compressedFlag == 0 {
+		return payload, nil
+	}
+
+	r := newReader(payload)
+	size := r.uint32()
+	checksum := r.uint32()
+
+	if r.err != nil {
+		return nil, errMalformedPacket
+	}
+
+	decompressed, err := io.ReadAll(io.LimitReader(bzip2.NewReader(bytes.NewReader(r.rest())), int64(size)))
+	if err != nil {
+		return nil, fmt.Errorf("bzip2.Read: %w", err)
+	}
+
+	if crc32.ChecksumIEEE(decompressed) != checksum {
+		return nil, errChecksumMismatch
+	}
+
+	return decompressed, nil
+}
+
+// reader reads little-endian values and null-terminated strings, remembering first error.
+type reader struct {
+	buf []byte
+	err error
+}
+
+func newReader(data []byte) *reader {
+	return &reader{buf: data}
+}
+
+func (r *reader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if n > len(r.buf) {
+		r.err = errMalformedPacket
+		return nil
+	}
+
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+
+	return b
+}
+
+func (r *reader) rest() []byte {
+	b := r.buf
+	r.buf = nil
+
+	return b
+}
+
+func (r *reader) uint8() uint8 {
+	if b := r.next(1); b != nil {
+		return b[0]
+	}
+
+	return 0
+}
+
+func (r *reader) uint16() uint16 {
+	if b := r.next(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+
+	return 0
+}
+
+func (r *reader) uint32() uint32 {
+	if b := r.next(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+
+	return 0
+}
+
+func (r *reader) uint64() uint64 {
+	if b := r.next(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+
+	return 0
+}
+
+func (r *reader) float32() float32 {
+	return math.Float32frombits(r.uint32())
+}
+
+func (r *reader) string() string {
+	if r.err != nil {
+		return ""
+	}
+
+	i := bytes.IndexByte(r.buf, 0)
+	if i < 0 {
+		r.err = errMalformedPacket
+		return ""
+	}
+
+	s := string(r.buf[:i])
+	r.buf = r.buf[i+1:]
+
+	return s
+}
+
+func decodeInfo(payload []byte) (Info, error) {
+	r := newReader(payload)
+
+	if r.uint8() != responseInfo {
+		return Info{}, errUnexpectedResponse
+	}
+
+	info := Info{
+		Protocol:   r.uint8(),
+		Name:       r.string(),
+		Map:        r.string(),
+		Folder:     r.string(),
+		Game:       r.string(),
+		AppID:      r.uint16(),
+		Players:    r.uint8(),
+		MaxPlayers: r.uint8(),
+		Bots:       r.uint8(),
+		ServerType: r.uint8(),
+		OS:         r.uint8(),
+		Passworded: r.uint8() == 1,
+		VAC:        r.uint8() == 1,
+	}
+
+	info.Version = r.string()
+
+	if r.err != nil {
+		return Info{}, r.err
+	}
+
+	if len(r.buf) == 0 {
+		return info, nil
+	}
+
+	edf := r.uint8()
+
+	if edf&edfPort != 0 {
+		_ = r.uint16()
+	}
+
+	if edf&edfSteamID != 0 {
+		_ = r.uint64()
+	}
+
+	if edf&edfSourceTV != 0 {
+		_ = r.uint16()
+		_ = r.string()
+	}
+
+	if edf&edfKeywords != 0 {
+		info.Keywords = r.string()
+	}
+
+	if r.err != nil {
+		return Info{}, r.err
+	}
+
+	return info, nil
+}
+
+func decodePlayers(payload []byte) ([]Player, error) {
+	r := newReader(payload)
+
+	if r.uint8() != responsePlayer {
+		return nil, errUnexpectedResponse
+	}
+
+	count := int(r.uint8())
+	players := make([]Player, 0, count)
+
+	for range count {
+		player := Player{
+			Index: r.uint8(),
+			Name:  r.string(),
+			Score: int32(r.uint32()), //nolint:gosec
+		}
+		player.Duration = time.Duration(float64(r.float32()) * float64(time.Second))
+
+		if r.err != nil {
+			return nil, r.err
+		}
+
+		players = append(players, player)
+	}
+
+	return players, nil
+}
+
+func decodeRules(payload []byte) (Rules, error) {
+	r := newReader(payload)
+
+	if r.uint8() != responseRules {
+		return nil, errUnexpectedResponse
+	}
+
+	count := int(r.uint16())
+	rules := make(Rules, count)
+
+	for range count {
+		name := r.string()
+		value := r.string()
+
+		if r.err != nil {
+			return nil, r.err
+		}
+
+		rules[name] = value
+	}
+
+	return rules, nil
+}
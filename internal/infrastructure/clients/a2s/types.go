@@ -0,0 +1,37 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package a2s
+
+import "time"
+
+// Info is a response to A2S_INFO query.
+type Info struct {
+	Protocol   uint8
+	Name       string
+	Map        string
+	Folder     string
+	Game       string
+	AppID      uint16
+	Players    uint8
+	MaxPlayers uint8
+	Bots       uint8
+	ServerType byte
+	OS         byte
+	Passworded bool
+	VAC        bool
+	Version    string
+	// Keywords are server tags, available only when server sends extra data.
+	Keywords string
+}
+
+// Player is a single player from A2S_PLAYER response.
+type Player struct {
+	Index    uint8
+	Name     string
+	Score    int32
+	Duration time.Duration
+}
+
+// Rules is a response to A2S_RULES query.
+type Rules map[string]string
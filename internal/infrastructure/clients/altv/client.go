@@ -7,7 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+
+	"github.com/EpicStep/gdatum/internal/infrastructure/egress"
 )
 
 const (
@@ -16,18 +19,25 @@ const (
 
 // Client ...
 type Client struct {
-	client *http.Client
+	client        *http.Client
+	serverListURL string
+	cache         egress.Cache[Servers]
 }
 
 // NewOpts ...
 type NewOpts struct {
-	HTTPClient *http.Client
+	HTTPClient    *http.Client
+	ServerListURL string
 }
 
 func (o *NewOpts) setDefaults() {
 	if o.HTTPClient == nil {
 		o.HTTPClient = http.DefaultClient
 	}
+
+	if o.ServerListURL == "" {
+		o.ServerListURL = serverListURL
+	}
 }
 
 // New returns new Client.
@@ -35,30 +45,32 @@ func New(opts NewOpts) *Client {
 	opts.setDefaults()
 
 	return &Client{
-		client: opts.HTTPClient,
+		client:        opts.HTTPClient,
+		serverListURL: opts.ServerListURL,
 	}
 }
 
 // Servers returns ragemp servers.
 func (c *Client) Servers(ctx context.Context) (Servers, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, serverListURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.serverListURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("http.NewRequest: %w", err)
 	}
 
-	resp, err := c.client.Do(req)
+	respServers, err := c.cache.Do(c.client, req, decodeServers)
 	if err != nil {
-		return nil, fmt.Errorf("client.Do: %w", err)
+		return nil, fmt.Errorf("c.cache.Do: %w", err)
 	}
 
-	defer resp.Body.Close() //nolint:errcheck
+	return respServers, nil
+}
 
-	var respServers Servers
+func decodeServers(r io.Reader) (Servers, error) {
+	var servers Servers
 
-	err = json.NewDecoder(resp.Body).Decode(&respServers)
-	if err != nil {
+	if err := json.NewDecoder(r).Decode(&servers); err != nil {
 		return nil, fmt.Errorf("json.Decode: %w", err)
 	}
 
-	return respServers, nil
+	return servers, nil
 }
@@ -0,0 +1,87 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package altv
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Servers(t *testing.T) {
+	t.Parallel()
+
+	const etag = `W/"5f3a"`
+
+	var notModified atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(`[{"name":"Test","gameMode":"rp","website":"test.gg","language":"de","playersCount":3,"maxPlayersCount":100,"passworded":true,"version":"16.2","tags":["roleplay"],"address":"127.0.0.1:7788"}]`)) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	client := New(NewOpts{
+		HTTPClient:    srv.Client(),
+		ServerListURL: srv.URL,
+	})
+
+	want := Servers{
+		{
+			Name:            "Test",
+			Gamemode:        "rp",
+			Website:         "test.gg",
+			Language:        "de",
+			PlayersCount:    3,
+			MaxPlayersCount: 100,
+			Passworded:      true,
+			Version:         "16.2",
+			Tags:            []string{"roleplay"},
+			Address:         "127.0.0.1:7788",
+		},
+	}
+
+	for range 3 {
+		servers, err := client.Servers(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, want, servers)
+	}
+
+	assert.Equal(t, int32(2), notModified.Load())
+}
+
+func TestVersion_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data string
+		want Version
+	}{
+		{name: "String", data: `"16.2"`, want: "16.2"},
+		{name: "Number", data: `15.1`, want: "15.1"},
+		{name: "Null", data: `null`, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var version Version
+			require.NoError(t, version.UnmarshalJSON([]byte(tt.data)))
+			assert.Equal(t, tt.want, version)
+		})
+	}
+}
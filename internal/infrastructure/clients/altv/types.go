@@ -3,15 +3,46 @@
 
 package altv
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // Servers is a altv servers.
 type Servers []Server
 
 // Server is a altv server.
 type Server struct {
-	Name         string `json:"name"`
-	Gamemode     string `json:"gameMode"`
-	Website      string `json:"website"`
-	Language     string `json:"language"`
-	PlayersCount int32  `json:"playersCount"`
-	Address      string `json:"address"`
+	Name            string   `json:"name"`
+	Gamemode        string   `json:"gameMode"`
+	Website         string   `json:"website"`
+	Language        string   `json:"language"`
+	PlayersCount    int32    `json:"playersCount"`
+	MaxPlayersCount int32    `json:"maxPlayersCount"`
+	Passworded      bool     `json:"passworded"`
+	Version         Version  `json:"version"`
+	Tags            []string `json:"tags"`
+	Address         string   `json:"address"`
+}
+
+// Version is a server version, that API returns either as a string or as a number.
+type Version string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var version any
+	if err := json.Unmarshal(data, &version); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	switch version := version.(type) {
+	case nil:
+		*v = ""
+	case string:
+		*v = Version(version)
+	default:
+		*v = Version(string(data))
+	}
+
+	return nil
 }
@@ -0,0 +1,77 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package cfx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/EpicStep/gdatum/internal/infrastructure/egress"
+)
+
+const (
+	serverListURL = "https://servers-frontend.fivem.net/api/servers/streamRedir/"
+
+	// defaultCacheMaxAge is enough for FiveM and RedM collections of the same tick to share one download.
+	defaultCacheMaxAge = time.Minute
+)
+
+// Client ...
+type Client struct {
+	client        *http.Client
+	serverListURL string
+	cache         egress.Cache[Servers]
+}
+
+// NewOpts ...
+type NewOpts struct {
+	HTTPClient    *http.Client
+	ServerListURL string
+	// CacheMaxAge is a time, that server list is reused without request, so client may be shared by games.
+	CacheMaxAge time.Duration
+}
+
+func (o *NewOpts) setDefaults() {
+	if o.HTTPClient == nil {
+		o.HTTPClient = http.DefaultClient
+	}
+
+	if o.ServerListURL == "" {
+		o.ServerListURL = serverListURL
+	}
+
+	if o.CacheMaxAge <= 0 {
+		o.CacheMaxAge = defaultCacheMaxAge
+	}
+}
+
+// New returns new Client.
+func New(opts NewOpts) *Client {
+	opts.setDefaults()
+
+	return &Client{
+		client:        opts.HTTPClient,
+		serverListURL: opts.ServerListURL,
+		cache: egress.Cache[Servers]{
+			MaxAge: opts.CacheMaxAge,
+		},
+	}
+}
+
+// Servers returns Cfx.re servers of all games, one Client should be shared by all games to download list once.
+func (c *Client) Servers(ctx context.Context) (Servers, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.serverListURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequest: %w", err)
+	}
+
+	respServers, err := c.cache.Do(c.client, req, decodeStream)
+	if err != nil {
+		return nil, fmt.Errorf("c.cache.Do: %w", err)
+	}
+
+	return respServers, nil
+}
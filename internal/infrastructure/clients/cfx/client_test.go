@@ -0,0 +1,101 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package cfx
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Servers(t *testing.T) {
+	t.Parallel()
+
+	fixture, err := os.ReadFile("testdata/servers.bin")
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(fixture) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	client := New(NewOpts{
+		HTTPClient:    srv.Client(),
+		ServerListURL: srv.URL,
+	})
+
+	servers, err := client.Servers(t.Context())
+	require.NoError(t, err)
+	require.Len(t, servers, 3)
+
+	assert.Equal(t, "3lamjz", servers[0].EndpointID)
+	assert.Equal(t, "^1Eclipse ^7Roleplay | Serious RP", servers[0].Hostname)
+	assert.Equal(t, "Roleplay", servers[0].Gametype)
+	assert.Equal(t, int32(412), servers[0].Clients)
+	assert.Equal(t, int32(2048), servers[0].MaxClients)
+	assert.Equal(t, "en-US", servers[0].Locale())
+	assert.Equal(t, GameFivem, servers[0].Game())
+
+	assert.Equal(t, "pz8m77", servers[1].EndpointID)
+	assert.Equal(t, GameFivem, servers[1].Game())
+
+	assert.Equal(t, "qxa9rk", servers[2].EndpointID)
+	assert.Equal(t, "Western RP", servers[2].Gametype)
+	assert.Equal(t, GameRedm, servers[2].Game())
+}
+
+func TestDecodeStream(t *testing.T) {
+	t.Parallel()
+
+	fixture, err := os.ReadFile("testdata/servers.bin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			data: fixture,
+			want: 3,
+		},
+		{
+			name: "Empty",
+			data: nil,
+			want: 0,
+		},
+		{
+			name:    "TruncatedFrame",
+			data:    fixture[:len(fixture)-1],
+			wantErr: true,
+		},
+		{
+			name:    "FrameTooLarge",
+			data:    []byte{0xff, 0xff, 0xff, 0xff},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			servers, err := decodeStream(bytes.NewReader(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, servers, tt.want)
+		})
+	}
+}
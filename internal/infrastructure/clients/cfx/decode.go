@@ -0,0 +1,193 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package cfx
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the master list protobuf messages.
+const (
+	serverEndpointField = 1
+	serverDataField     = 2
+
+	dataMaxClientsField = 1
+	dataClientsField    = 2
+	dataHostnameField   = 4
+	dataGametypeField   = 5
+	dataMapnameField    = 6
+	dataVarsField       = 12
+
+	mapEntryKeyField   = 1
+	mapEntryValueField = 2
+)
+
+const (
+	frameLengthSize = 4
+	maxFrameLength  = 1 << 20 // 1 MiB
+)
+
+var (
+	errFrameTooLarge = errors.New("frame is too large")
+)
+
+// decodeStream decodes a stream of length-prefixed Server messages.
+// Every frame is a little-endian uint32 length followed by the message itself.
+func decodeStream(r io.Reader) (Servers, error) {
+	br := bufio.NewReader(r)
+
+	var (
+		servers Servers
+		header  [frameLengthSize]byte
+		frame   []byte
+	)
+
+	for {
+		if _, err := io.ReadFull(br, header[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return servers, nil
+			}
+
+			return nil, fmt.Errorf("io.ReadFull: %w", err)
+		}
+
+		length := binary.LittleEndian.Uint32(header[:])
+		if length > maxFrameLength {
+			return nil, errFrameTooLarge
+		}
+
+		if cap(frame) < int(length) {
+			frame = make([]byte, length)
+		}
+		frame = frame[:length]
+
+		if _, err := io.ReadFull(br, frame); err != nil {
+			return nil, fmt.Errorf("io.ReadFull: %w", err)
+		}
+
+		server, err := decodeServer(frame)
+		if err != nil {
+			return nil, fmt.Errorf("decodeServer: %w", err)
+		}
+
+		servers = append(servers, server)
+	}
+}
+
+func decodeServer(b []byte) (Server, error) {
+	var server Server
+
+	err := walkFields(b, func(num protowire.Number, typ protowire.Type, value []byte, _ uint64) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+
+		switch num {
+		case serverEndpointField:
+			server.EndpointID = string(value)
+		case serverDataField:
+			return decodeServerData(value, &server)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Server{}, err
+	}
+
+	return server, nil
+}
+
+func decodeServerData(b []byte, server *Server) error {
+	return walkFields(b, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		switch {
+		case typ == protowire.VarintType && num == dataMaxClientsField:
+			server.MaxClients = int32(varint) //nolint:gosec
+		case typ == protowire.VarintType && num == dataClientsField:
+			server.Clients = int32(varint) //nolint:gosec
+		case typ == protowire.BytesType && num == dataHostnameField:
+			server.Hostname = string(value)
+		case typ == protowire.BytesType && num == dataGametypeField:
+			server.Gametype = string(value)
+		case typ == protowire.BytesType && num == dataMapnameField:
+			server.Mapname = string(value)
+		case typ == protowire.BytesType && num == dataVarsField:
+			key, val, err := decodeMapEntry(value)
+			if err != nil {
+				return fmt.Errorf("decodeMapEntry: %w", err)
+			}
+
+			if server.Vars == nil {
+				server.Vars = make(map[string]string)
+			}
+
+			server.Vars[key] = val
+		}
+
+		return nil
+	})
+}
+
+func decodeMapEntry(b []byte) (key, value string, err error) {
+	err = walkFields(b, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+
+		switch num {
+		case mapEntryKeyField:
+			key = string(v)
+		case mapEntryValueField:
+			value = string(v)
+		}
+
+		return nil
+	})
+
+	return key, value, err
+}
+
+type fieldFunc func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error
+
+// walkFields calls fn for every varint and length-delimited field of the message, other fields are skipped.
+func walkFields(b []byte, fn fieldFunc) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return fmt.Errorf("protowire.ConsumeTag: %w", protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		var (
+			value  []byte
+			varint uint64
+		)
+
+		switch typ { //revive:disable:enforce-switch-style
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+
+		if n < 0 {
+			return fmt.Errorf("protowire.ConsumeFieldValue: %w", protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		if err := fn(num, typ, value, varint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
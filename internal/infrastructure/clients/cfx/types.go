@@ -0,0 +1,58 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package cfx
+
+import "strings"
+
+const (
+	// GameFivem is a value of the gamename var for FiveM servers.
+	GameFivem = "gta5"
+	// GameRedm is a value of the gamename var for RedM servers.
+	GameRedm = "rdr3"
+
+	gameNameVar = "gamename"
+	localeVar   = "locale"
+	tagsVar     = "tags"
+)
+
+// Servers is a Cfx.re servers.
+type Servers []Server
+
+// Server is a Cfx.re server.
+type Server struct {
+	EndpointID string
+	Hostname   string
+	Gametype   string
+	Mapname    string
+	Clients    int32
+	MaxClients int32
+	Vars       map[string]string
+}
+
+// Game returns game of the server, FiveM servers may not set it.
+func (s Server) Game() string {
+	if game := s.Vars[gameNameVar]; game != "" {
+		return game
+	}
+
+	return GameFivem
+}
+
+// Locale returns locale of the server.
+func (s Server) Locale() string {
+	return s.Vars[localeVar]
+}
+
+// Tags returns tags of the server.
+func (s Server) Tags() []string {
+	var tags []string
+
+	for tag := range strings.SplitSeq(s.Vars[tagsVar], ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
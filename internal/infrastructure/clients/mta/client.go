@@ -0,0 +1,143 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package mta
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/EpicStep/gdatum/internal/infrastructure/egress"
+)
+
+const (
+	serverListURL = "https://master.multitheftauto.com/ase/mta/"
+
+	// asePortOffset is an offset of ASE query port from server game port.
+	asePortOffset = 123
+	aseMagic      = "EYE1"
+	aseRequest    = "s"
+
+	defaultQueryTimeout = 3 * time.Second
+	maxPacketSize       = 8192
+)
+
+// Client ...
+type Client struct {
+	client        *http.Client
+	serverListURL string
+	queryTimeout  time.Duration
+	cache         egress.Cache[Servers]
+}
+
+// NewOpts ...
+type NewOpts struct {
+	HTTPClient    *http.Client
+	ServerListURL string
+	// QueryTimeout is a timeout of the single ASE query round trip.
+	QueryTimeout time.Duration
+}
+
+func (o *NewOpts) setDefaults() {
+	if o.HTTPClient == nil {
+		o.HTTPClient = http.DefaultClient
+	}
+
+	if o.ServerListURL == "" {
+		o.ServerListURL = serverListURL
+	}
+
+	if o.QueryTimeout <= 0 {
+		o.QueryTimeout = defaultQueryTimeout
+	}
+}
+
+// New returns new Client.
+func New(opts NewOpts) *Client {
+	opts.setDefaults()
+
+	return &Client{
+		client:        opts.HTTPClient,
+		serverListURL: opts.ServerListURL,
+		queryTimeout:  opts.QueryTimeout,
+	}
+}
+
+// Servers returns servers from MTA:SA master server.
+func (c *Client) Servers(ctx context.Context) (Servers, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.serverListURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequest: %w", err)
+	}
+
+	servers, err := c.cache.Do(c.client, req, func(r io.Reader) (Servers, error) {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("io.ReadAll: %w", err)
+		}
+
+		return decodeList(data)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("c.cache.Do: %w", err)
+	}
+
+	return servers, nil
+}
+
+// Query returns server info using All-Seeing Eye protocol on game port + 123.
+func (c *Client) Query(ctx context.Context, address string) (Server, error) {
+	host, portRaw, err := net.SplitHostPort(address)
+	if err != nil {
+		return Server{}, fmt.Errorf("net.SplitHostPort: %w", err)
+	}
+
+	port, err := strconv.Atoi(portRaw)
+	if err != nil {
+		return Server{}, fmt.Errorf("strconv.Atoi: %w", err)
+	}
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "udp", net.JoinHostPort(host, strconv.Itoa(port+asePortOffset)))
+	if err != nil {
+		return Server{}, fmt.Errorf("dialer.DialContext: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	deadline := time.Now().Add(c.queryTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	if err = conn.SetDeadline(deadline); err != nil {
+		return Server{}, fmt.Errorf("conn.SetDeadline: %w", err)
+	}
+
+	if _, err = conn.Write([]byte(aseRequest)); err != nil {
+		return Server{}, fmt.Errorf("conn.Write: %w", err)
+	}
+
+	buf := make([]byte, maxPacketSize)
+
+	n, err := conn.Read(buf)
+	if err != nil {
+		return Server{}, fmt.Errorf("conn.Read: %w", err)
+	}
+
+	server := Server{
+		Address: address,
+	}
+
+	if err = decodeASE(buf[:n], &server); err != nil {
+		return Server{}, fmt.Errorf("decodeASE: %w", err)
+	}
+
+	return server, nil
+}
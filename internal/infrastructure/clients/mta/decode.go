@@ -0,0 +1,259 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package mta
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// Flags of the master list items, that describe which fields are present.
+const (
+	flagPlayerCount    = 0x0004
+	flagMaxPlayerCount = 0x0008
+	flagGameName       = 0x0010
+	flagServerName     = 0x0020
+	flagGameMode       = 0x0040
+	flagMapName        = 0x0080
+	flagServerVersion  = 0x0100
+	flagPassworded     = 0x0200
+)
+
+const (
+	listVersion2 = 2
+
+	// addressSize is a size of IPv4 address and port.
+	addressSize = 6
+)
+
+var (
+	errMalformedList       = errors.New("malformed server list")
+	errUnsupportedVersion  = errors.New("unsupported server list version")
+	errMalformedASEPayload = errors.New("malformed ASE response")
+)
+
+// listReader reads little-endian values from master list, remembering first error.
+type listReader struct {
+	buf []byte
+	err error
+}
+
+func (r *listReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if n < 0 || n > len(r.buf) {
+		r.err = errMalformedList
+		return nil
+	}
+
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+
+	return b
+}
+
+func (r *listReader) uint8() uint8 {
+	if b := r.next(1); b != nil {
+		return b[0]
+	}
+
+	return 0
+}
+
+func (r *listReader) uint16() uint16 {
+	if b := r.next(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+
+	return 0
+}
+
+func (r *listReader) uint32() uint32 {
+	if b := r.next(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+
+	return 0
+}
+
+func (r *listReader) string() string {
+	return string(r.next(int(r.uint8())))
+}
+
+func (r *listReader) address() string {
+	b := r.next(addressSize)
+	if b == nil {
+		return ""
+	}
+
+	ip := net.IPv4(b[0], b[1], b[2], b[3])
+	port := binary.LittleEndian.Uint16(b[4:])
+
+	return net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+}
+
+// decodeList decodes binary master server list.
+//
+// Legacy list (version 0) is a uint16 count followed by addresses.
+// Version 2 list starts with zero count, followed by version, flags, sequence number,
+// items count and items. Every item is prefixed by its length (including the length itself),
+// so fields, that are not known to decoder, can be skipped.
+func decodeList(data []byte) (Servers, error) {
+	r := &listReader{buf: data}
+
+	count := int(r.uint16())
+	if count != 0 {
+		return decodeListVersion0(r, count)
+	}
+
+	if version := r.uint16(); r.err == nil && version != listVersion2 {
+		return nil, fmt.Errorf("%w: %d", errUnsupportedVersion, version)
+	}
+
+	flags := r.uint32()
+	_ = r.uint32() // sequence number
+	count = int(r.uint32())
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	servers := make(Servers, 0, min(count, len(r.buf)/addressSize))
+
+	for range count {
+		length := int(r.uint16())
+		item := &listReader{buf: r.next(length - 2)}
+
+		if r.err != nil {
+			return nil, r.err
+		}
+
+		server, err := decodeItem(item, flags)
+		if err != nil {
+			return nil, err
+		}
+
+		servers = append(servers, server)
+	}
+
+	return servers, nil
+}
+
+func decodeListVersion0(r *listReader, count int) (Servers, error) {
+	servers := make(Servers, 0, min(count, len(r.buf)/addressSize))
+
+	for range count {
+		address := r.address()
+		if r.err != nil {
+			return nil, r.err
+		}
+
+		servers = append(servers, Server{Address: address})
+	}
+
+	return servers, nil
+}
+
+func decodeItem(r *listReader, flags uint32) (Server, error) {
+	server := Server{
+		Address: r.address(),
+	}
+
+	if flags&flagPlayerCount != 0 {
+		server.Players = int32(r.uint16())
+	}
+
+	if flags&flagMaxPlayerCount != 0 {
+		server.MaxPlayers = int32(r.uint16())
+	}
+
+	if flags&flagGameName != 0 {
+		_ = r.string()
+	}
+
+	if flags&flagServerName != 0 {
+		server.Name = r.string()
+	}
+
+	if flags&flagGameMode != 0 {
+		server.Gamemode = r.string()
+	}
+
+	if flags&flagMapName != 0 {
+		server.Map = r.string()
+	}
+
+	if flags&flagServerVersion != 0 {
+		server.Version = r.string()
+	}
+
+	if flags&flagPassworded != 0 {
+		passworded := r.uint8() == 1
+		server.Passworded = &passworded
+	}
+
+	// remaining fields are not used.
+
+	if r.err != nil {
+		return Server{}, r.err
+	}
+
+	return server, nil
+}
+
+// decodeASE decodes All-Seeing Eye response into server.
+//
+// Response starts with "EYE1" magic, followed by strings prefixed with length,
+// that includes length byte itself: game name, port, server name, game type, map name,
+// version, passworded, players count and max players count.
+func decodeASE(data []byte, server *Server) error {
+	if len(data) < len(aseMagic) || string(data[:len(aseMagic)]) != aseMagic {
+		return errMalformedASEPayload
+	}
+
+	data = data[len(aseMagic):]
+
+	const fieldsCount = 9
+
+	fields := make([]string, 0, fieldsCount)
+	for range fieldsCount {
+		if len(data) == 0 {
+			return errMalformedASEPayload
+		}
+
+		length := int(data[0])
+		if length < 1 || length > len(data) {
+			return errMalformedASEPayload
+		}
+
+		fields = append(fields, string(data[1:length]))
+		data = data[length:]
+	}
+
+	players, err := strconv.ParseInt(fields[7], 10, 32)
+	if err != nil {
+		return fmt.Errorf("strconv.ParseInt: %w", err)
+	}
+
+	maxPlayers, err := strconv.ParseInt(fields[8], 10, 32)
+	if err != nil {
+		return fmt.Errorf("strconv.ParseInt: %w", err)
+	}
+
+	server.Name = fields[2]
+	server.Gamemode = fields[3]
+	server.Map = fields[4]
+	server.Version = fields[5]
+	passworded := fields[6] == "1"
+	server.Passworded = &passworded
+	server.Players = int32(players)
+	server.MaxPlayers = int32(maxPlayers)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package mta
+
+import (
+	"os"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeList(t *testing.T) {
+	t.Parallel()
+
+	fixture, err := os.ReadFile("testdata/servers.bin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    Servers
+		wantErr bool
+	}{
+		{
+			name: "Version2",
+			data: fixture,
+			want: Servers{
+				{
+					Address:    "185.71.66.12:22003",
+					Name:       "[RUS] MTA Province | Roleplay",
+					Gamemode:   "Province RP",
+					Map:        "San Andreas",
+					Version:    "1.6-9.22800",
+					Players:    318,
+					MaxPlayers: 1024,
+					Passworded: lo.ToPtr(false),
+				},
+				{
+					Address:    "51.38.92.140:22010",
+					Name:       "Los Santos DM Arena",
+					Gamemode:   "DM",
+					Map:        "dm-arena",
+					Version:    "1.6-9.22741",
+					Players:    12,
+					MaxPlayers: 64,
+					Passworded: lo.ToPtr(true),
+				},
+				{
+					Address:    "46.174.48.200:22003",
+					Name:       "Race Mania",
+					Gamemode:   "Race",
+					Map:        "race-stadium",
+					Version:    "1.6-9.22800",
+					MaxPlayers: 32,
+					Passworded: lo.ToPtr(false),
+				},
+			},
+		},
+		{
+			name: "Version0",
+			data: []byte{0x02, 0x00, 127, 0, 0, 1, 0xf3, 0x55, 10, 0, 0, 2, 0xf4, 0x55},
+			want: Servers{
+				{Address: "127.0.0.1:22003"},
+				{Address: "10.0.0.2:22004"},
+			},
+		},
+		{
+			name:    "UnsupportedVersion",
+			data:    []byte{0x00, 0x00, 0x03, 0x00},
+			wantErr: true,
+		},
+		{
+			name:    "Truncated",
+			data:    fixture[:len(fixture)-4],
+			wantErr: true,
+		},
+		{
+			name:    "Empty",
+			data:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			servers, err := decodeList(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, servers)
+		})
+	}
+}
+
+func TestDecodeASE(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("EYE1")
+	for _, field := range []string{"mta", "22003", "Race Mania", "Race", "race-stadium", "1.6", "0", "7", "32", "", "next"} {
+		data = append(data, byte(len(field)+1))
+		data = append(data, field...)
+	}
+
+	var server Server
+	require.NoError(t, decodeASE(data, &server))
+
+	assert.Equal(t, Server{
+		Name:       "Race Mania",
+		Gamemode:   "Race",
+		Map:        "race-stadium",
+		Version:    "1.6",
+		Players:    7,
+		MaxPlayers: 32,
+		Passworded: lo.ToPtr(false),
+	}, server)
+
+	assert.Error(t, decodeASE([]byte("EYE2"), &server))
+	assert.Error(t, decodeASE(data[:10], &server))
+}
@@ -0,0 +1,21 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package mta
+
+// Servers is a MTA:SA servers.
+type Servers []Server
+
+// Server is a MTA:SA server.
+type Server struct {
+	// Address is a server game address in host:port format.
+	Address    string
+	Name       string
+	Gamemode   string
+	Map        string
+	Version    string
+	Players    int32
+	MaxPlayers int32
+	// Passworded is nil, when master list doesn't carry password flag.
+	Passworded *bool
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package ragemp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Servers(t *testing.T) {
+	t.Parallel()
+
+	const lastModified = "Sat, 18 Oct 2025 10:00:00 GMT"
+
+	var notModified atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = w.Write([]byte(`{"127.0.0.1:22005":{"name":"Test RP","gamemode":"freeroam","url":"test.rp","lang":"en","players":15,"peak":120,"maxplayers":500}}`)) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	client := New(NewOpts{
+		HTTPClient:    srv.Client(),
+		ServerListURL: srv.URL,
+	})
+
+	want := Servers{
+		"127.0.0.1:22005": {
+			Name:       "Test RP",
+			Gamemode:   "freeroam",
+			URL:        "test.rp",
+			Language:   "en",
+			Players:    15,
+			Peak:       120,
+			MaxPlayers: 500,
+		},
+	}
+
+	for range 2 {
+		servers, err := client.Servers(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, want, servers)
+	}
+
+	assert.Equal(t, int32(1), notModified.Load())
+}
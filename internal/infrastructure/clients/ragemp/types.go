@@ -8,9 +8,11 @@ type Servers map[string]Server
 
 // Server is a regemp server.
 type Server struct {
-	Name     string `json:"name"`
-	Gamemode string `json:"gamemode"`
-	URL      string `json:"url"`
-	Language string `json:"lang"`
-	Players  int32  `json:"players"`
+	Name       string `json:"name"`
+	Gamemode   string `json:"gamemode"`
+	URL        string `json:"url"`
+	Language   string `json:"lang"`
+	Players    int32  `json:"players"`
+	Peak       int32  `json:"peak"`
+	MaxPlayers int32  `json:"maxplayers"`
 }
@@ -0,0 +1,159 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package samp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/EpicStep/gdatum/internal/infrastructure/egress"
+)
+
+const (
+	serverListURL = "https://api.open.mp/servers"
+
+	defaultQueryTimeout = 3 * time.Second
+)
+
+// Client ...
+type Client struct {
+	client        *http.Client
+	serverListURL string
+	queryTimeout  time.Duration
+	cache         egress.Cache[ListedServers]
+}
+
+// NewOpts ...
+type NewOpts struct {
+	HTTPClient    *http.Client
+	ServerListURL string
+	// QueryTimeout is a timeout of the single query packet round trip.
+	QueryTimeout time.Duration
+}
+
+func (o *NewOpts) setDefaults() {
+	if o.HTTPClient == nil {
+		o.HTTPClient = http.DefaultClient
+	}
+
+	if o.ServerListURL == "" {
+		o.ServerListURL = serverListURL
+	}
+
+	if o.QueryTimeout <= 0 {
+		o.QueryTimeout = defaultQueryTimeout
+	}
+}
+
+// New returns new Client.
+func New(opts NewOpts) *Client {
+	opts.setDefaults()
+
+	return &Client{
+		client:        opts.HTTPClient,
+		serverListURL: opts.ServerListURL,
+		queryTimeout:  opts.QueryTimeout,
+	}
+}
+
+// ListServers returns servers from open.mp server list.
+func (c *Client) ListServers(ctx context.Context) (ListedServers, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.serverListURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequest: %w", err)
+	}
+
+	respServers, err := c.cache.Do(c.client, req, decodeListedServers)
+	if err != nil {
+		return nil, fmt.Errorf("c.cache.Do: %w", err)
+	}
+
+	return respServers, nil
+}
+
+func decodeListedServers(r io.Reader) (ListedServers, error) {
+	var servers ListedServers
+
+	if err := json.NewDecoder(r).Decode(&servers); err != nil {
+		return nil, fmt.Errorf("json.Decode: %w", err)
+	}
+
+	return servers, nil
+}
+
+// Query returns server info and rules, using SA-MP query protocol.
+func (c *Client) Query(ctx context.Context, address string) (Server, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "udp4", address)
+	if err != nil {
+		return Server{}, fmt.Errorf("dialer.DialContext: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	remoteAddr, ok := conn.RemoteAddr().(*net.UDPAddr)
+	if !ok {
+		return Server{}, errNotIPv4
+	}
+
+	server := Server{
+		Address: address,
+	}
+
+	infoRequest, infoResponse, err := c.roundTrip(ctx, conn, remoteAddr, opcodeInfo)
+	if err != nil {
+		return Server{}, fmt.Errorf("c.roundTrip: %w", err)
+	}
+
+	if err = decodeInfo(infoRequest, infoResponse, &server); err != nil {
+		return Server{}, fmt.Errorf("decodeInfo: %w", err)
+	}
+
+	rulesRequest, rulesResponse, err := c.roundTrip(ctx, conn, remoteAddr, opcodeRules)
+	if err != nil {
+		return Server{}, fmt.Errorf("c.roundTrip: %w", err)
+	}
+
+	server.Rules, err = decodeRules(rulesRequest, rulesResponse)
+	if err != nil {
+		return Server{}, fmt.Errorf("decodeRules: %w", err)
+	}
+
+	return server, nil
+}
+
+func (c *Client) roundTrip(ctx context.Context, conn net.Conn, addr *net.UDPAddr, opcode byte) (request, response []byte, err error) {
+	request, err = buildPacket(addr, opcode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("buildPacket: %w", err)
+	}
+
+	deadline := time.Now().Add(c.queryTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	if err = conn.SetDeadline(deadline); err != nil {
+		return nil, nil, fmt.Errorf("conn.SetDeadline: %w", err)
+	}
+
+	if _, err = conn.Write(request); err != nil {
+		return nil, nil, fmt.Errorf("conn.Write: %w", err)
+	}
+
+	buf := make([]byte, maxPacketSize)
+
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("conn.Read: %w", err)
+	}
+
+	return request, buf[:n], nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package samp
+
+import (
+	"encoding/binary"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeServer struct {
+	hostname   string
+	gamemode   string
+	language   string
+	players    uint16
+	maxPlayers uint16
+	passworded bool
+	rules      [][2]string
+	// silent server never responds.
+	silent bool
+}
+
+// startFakeServer starts UDP server, that responds to the query packets.
+func startFakeServer(t *testing.T, srv fakeServer) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close() //nolint:errcheck
+	})
+
+	go func() {
+		buf := make([]byte, maxPacketSize)
+
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			if srv.silent || n != headerSize {
+				continue
+			}
+
+			response := append([]byte(nil), buf[:headerSize]...)
+
+			switch buf[headerSize-1] {
+			case opcodeInfo:
+				response = append(response, boolToByte(srv.passworded))
+				response = binary.LittleEndian.AppendUint16(response, srv.players)
+				response = binary.LittleEndian.AppendUint16(response, srv.maxPlayers)
+				for _, s := range []string{srv.hostname, srv.gamemode, srv.language} {
+					response = binary.LittleEndian.AppendUint32(response, uint32(len(s))) //nolint:gosec
+					response = append(response, s...)
+				}
+			case opcodeRules:
+				response = binary.LittleEndian.AppendUint16(response, uint16(len(srv.rules))) //nolint:gosec
+				for _, rule := range srv.rules {
+					response = append(response, byte(len(rule[0])))
+					response = append(response, rule[0]...)
+					response = append(response, byte(len(rule[1])))
+					response = append(response, rule[1]...)
+				}
+			default:
+				continue
+			}
+
+			_, _ = conn.WriteTo(response, addr) //nolint:errcheck
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func boolToByte(v bool) byte {
+	if v {
+		return 1
+	}
+
+	return 0
+}
+
+func TestClient_Query(t *testing.T) {
+	t.Parallel()
+
+	address := startFakeServer(t, fakeServer{
+		hostname:   "Grove Street Roleplay",
+		gamemode:   "GS:RP 2.1",
+		language:   "English",
+		players:    57,
+		maxPlayers: 500,
+		passworded: true,
+		rules: [][2]string{
+			{"version", "omp 1.4.0.2779"},
+			{"weburl", "gsrp.example.com"},
+		},
+	})
+
+	client := New(NewOpts{})
+
+	server, err := client.Query(t.Context(), address)
+	require.NoError(t, err)
+
+	assert.Equal(t, Server{
+		Address:    address,
+		Hostname:   "Grove Street Roleplay",
+		Gamemode:   "GS:RP 2.1",
+		Language:   "English",
+		Players:    57,
+		MaxPlayers: 500,
+		Passworded: true,
+		Rules: map[string]string{
+			"version": "omp 1.4.0.2779",
+			"weburl":  "gsrp.example.com",
+		},
+	}, server)
+}
+
+func TestClient_QueryTimeout(t *testing.T) {
+	t.Parallel()
+
+	address := startFakeServer(t, fakeServer{silent: true})
+
+	client := New(NewOpts{QueryTimeout: 50 * time.Millisecond})
+
+	_, err := client.Query(t.Context(), address)
+	assert.Error(t, err)
+}
+
+func TestClient_ListServers(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"ip":"127.0.0.1:7777","hn":"First","pc":1,"pm":50},{"ip":"127.0.0.1:7778","hn":"Second"}]`)) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	client := New(NewOpts{
+		HTTPClient:    srv.Client(),
+		ServerListURL: srv.URL,
+	})
+
+	servers, err := client.ListServers(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, ListedServers{{Address: "127.0.0.1:7777"}, {Address: "127.0.0.1:7778"}}, servers)
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package samp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+)
+
+const (
+	opcodeInfo  = 'i'
+	opcodeRules = 'r'
+
+	// headerSize is a size of "SAMP" magic, IPv4 address, port and opcode.
+	headerSize = 11
+
+	maxPacketSize = 4096
+)
+
+var (
+	packetMagic = []byte("SAMP")
+
+	errNotIPv4           = errors.New("query protocol supports only IPv4 addresses")
+	errUnexpectedHeader  = errors.New("unexpected response header")
+	errMalformedResponse = errors.New("malformed response")
+)
+
+// buildPacket returns a query packet with provided opcode.
+func buildPacket(addr *net.UDPAddr, opcode byte) ([]byte, error) {
+	ip := addr.IP.To4()
+	if ip == nil {
+		return nil, errNotIPv4
+	}
+
+	packet := make([]byte, 0, headerSize)
+	packet = append(packet, packetMagic...)
+	packet = append(packet, ip...)
+	packet = binary.LittleEndian.AppendUint16(packet, uint16(addr.Port)) //nolint:gosec
+	packet = append(packet, opcode)
+
+	return packet, nil
+}
+
+// packetReader reads little-endian values from response, remembering first error.
+type packetReader struct {
+	buf *bytes.Reader
+	err error
+}
+
+func newPacketReader(request, response []byte) (*packetReader, error) {
+	if len(response) < headerSize || !bytes.Equal(response[:headerSize], request) {
+		return nil, errUnexpectedHeader
+	}
+
+	return &packetReader{buf: bytes.NewReader(response[headerSize:])}, nil
+}
+
+func (r *packetReader) read(data any) {
+	if r.err != nil {
+		return
+	}
+
+	if err := binary.Read(r.buf, binary.LittleEndian, data); err != nil {
+		r.err = errMalformedResponse
+	}
+}
+
+func (r *packetReader) uint8() uint8 {
+	var v uint8
+	r.read(&v)
+	return v
+}
+
+func (r *packetReader) uint16() uint16 {
+	var v uint16
+	r.read(&v)
+	return v
+}
+
+func (r *packetReader) uint32() uint32 {
+	var v uint32
+	r.read(&v)
+	return v
+}
+
+func (r *packetReader) string(length int) string {
+	if r.err != nil {
+		return ""
+	}
+
+	if length > r.buf.Len() {
+		r.err = errMalformedResponse
+		return ""
+	}
+
+	b := make([]byte, length)
+	_, _ = r.buf.Read(b) //nolint:errcheck
+
+	return string(b)
+}
+
+// decodeInfo decodes response to the 'i' packet.
+func decodeInfo(request, response []byte, server *Server) error {
+	r, err := newPacketReader(request, response)
+	if err != nil {
+		return err
+	}
+
+	server.Passworded = r.uint8() == 1
+	server.Players = int32(r.uint16())
+	server.MaxPlayers = int32(r.uint16())
+	server.Hostname = r.string(int(r.uint32()))
+	server.Gamemode = r.string(int(r.uint32()))
+	server.Language = r.string(int(r.uint32()))
+
+	if r.err != nil {
+		return fmt.Errorf("decode info: %w", r.err)
+	}
+
+	return nil
+}
+
+// decodeRules decodes response to the 'r' packet.
+func decodeRules(request, response []byte) (map[string]string, error) {
+	r, err := newPacketReader(request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int(r.uint16())
+	rules := make(map[string]string, count)
+
+	for range count {
+		name := r.string(int(r.uint8()))
+		value := r.string(int(r.uint8()))
+
+		if r.err != nil {
+			return nil, fmt.Errorf("decode rules: %w", r.err)
+		}
+
+		rules[name] = value
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,24 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package samp
+
+// ListedServers is an open.mp server list.
+type ListedServers []ListedServer
+
+// ListedServer is a server from open.mp server list.
+type ListedServer struct {
+	Address string `json:"ip"`
+}
+
+// Server is a SA-MP server info, obtained by query protocol.
+type Server struct {
+	Address    string
+	Hostname   string
+	Gamemode   string
+	Language   string
+	Players    int32
+	MaxPlayers int32
+	Passworded bool
+	Rules      map[string]string
+}
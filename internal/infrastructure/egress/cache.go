@@ -0,0 +1,89 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package egress
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	errUnexpectedNotModified = errors.New("upstream responded not modified, but there is no cached response")
+)
+
+// DecodeFunc decodes response body.
+type DecodeFunc[T any] func(r io.Reader) (T, error)
+
+// Cache keeps last decoded response with its ETag and Last-Modified validators,
+// so unchanged responses are neither downloaded nor decoded again. Zero value is ready to use.
+type Cache[T any] struct {
+	// MaxAge is a time, that cached response is returned without request at all,
+	// it is useful when cache is shared by sources collected at the same time.
+	MaxAge time.Duration
+
+	mu sync.Mutex
+
+	etag         string
+	lastModified string
+	value        T
+	ok           bool
+	validatedAt  time.Time
+}
+
+// Do sends conditional request with validators of the cached response.
+// On 304 Not Modified cached value is returned, otherwise response is decoded and cached.
+func (c *Cache[T]) Do(client *http.Client, req *http.Request, decode DecodeFunc[T]) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero T
+
+	if c.ok && time.Since(c.validatedAt) < c.MaxAge {
+		return c.value, nil
+	}
+
+	if c.ok {
+		if c.etag != "" {
+			req.Header.Set("If-None-Match", c.etag)
+		}
+
+		if c.lastModified != "" {
+			req.Header.Set("If-Modified-Since", c.lastModified)
+		}
+	}
+
+	resp, err := Do(client, req)
+	if err != nil {
+		return zero, fmt.Errorf("Do: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode == http.StatusNotModified {
+		if !c.ok {
+			return zero, errUnexpectedNotModified
+		}
+
+		c.validatedAt = time.Now()
+
+		return c.value, nil
+	}
+
+	value, err := decode(resp.Body)
+	if err != nil {
+		return zero, err
+	}
+
+	c.etag = resp.Header.Get("ETag")
+	c.lastModified = resp.Header.Get("Last-Modified")
+	c.value = value
+	c.ok = true
+	c.validatedAt = time.Now()
+
+	return value, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package egress
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingMetrics struct {
+	notModified atomic.Int32
+}
+
+func (m *countingMetrics) RecordNotModified(string) {
+	m.notModified.Add(1)
+}
+
+func TestCache_Do(t *testing.T) {
+	t.Parallel()
+
+	const etag = `"v1"`
+
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte("payload")) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	metrics := &countingMetrics{}
+
+	client, err := New(Opts{Metrics: metrics})
+	require.NoError(t, err)
+
+	var (
+		cache   Cache[string]
+		decodes int
+	)
+
+	decode := func(r io.Reader) (string, error) {
+		decodes++
+
+		b, err := io.ReadAll(r)
+		return string(b), err
+	}
+
+	for range 3 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+
+		value, err := cache.Do(client, req, decode)
+		require.NoError(t, err)
+		assert.Equal(t, "payload", value)
+	}
+
+	assert.Equal(t, int32(3), requests.Load())
+	assert.Equal(t, 1, decodes)
+	assert.Equal(t, int32(2), metrics.notModified.Load())
+}
+
+func TestCache_DoUnexpectedNotModified(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := New(Opts{})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	var cache Cache[string]
+
+	_, err = cache.Do(client, req, func(io.Reader) (string, error) {
+		return "", nil
+	})
+	assert.ErrorIs(t, err, errUnexpectedNotModified)
+}
+
+func TestCache_DoMaxAge(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte("payload")) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := New(Opts{})
+	require.NoError(t, err)
+
+	cache := Cache[string]{MaxAge: time.Hour}
+
+	for range 3 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+
+		value, err := cache.Do(client, req, func(r io.Reader) (string, error) {
+			b, err := io.ReadAll(r)
+			return string(b), err
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "payload", value)
+	}
+
+	assert.Equal(t, int32(1), requests.Load())
+}
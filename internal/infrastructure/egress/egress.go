@@ -0,0 +1,223 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package egress
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/EpicStep/gdatum/internal/utils/buildinfo"
+)
+
+const (
+	defaultTimeout     = 30 * time.Second
+	defaultMaxBodySize = 64 << 20 // 64 MiB
+
+	// maxDrainSize is a maximum size of error response body, that is read to reuse connection.
+	maxDrainSize = 4 << 10
+)
+
+var (
+	// ErrBodyTooLarge is returned on reading response body, that is bigger than max body size.
+	ErrBodyTooLarge = errors.New("response body is too large")
+
+	errUnsupportedProxyScheme = errors.New("unsupported proxy scheme")
+)
+
+// StatusError is returned when upstream responds with unexpected status code.
+type StatusError struct {
+	StatusCode int
+	URL        string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code %d from %s", e.StatusCode, e.URL)
+}
+
+// Metrics is a metrics that client writes.
+type Metrics interface {
+	RecordNotModified(host string)
+}
+
+// Opts ...
+type Opts struct {
+	// Timeout of the whole request, including reading of the body.
+	Timeout time.Duration
+	// ProxyURL is an optional proxy, supported schemes are http, https and socks5.
+	ProxyURL string
+	// MaxBodySize is a maximum size of the decompressed response body.
+	MaxBodySize int64
+	// UserAgent overrides default gdatum User-Agent.
+	UserAgent string
+
+	Metrics Metrics
+	Logger  *zap.Logger
+}
+
+func (o *Opts) setDefaults() {
+	if o.Timeout <= 0 {
+		o.Timeout = defaultTimeout
+	}
+
+	if o.MaxBodySize <= 0 {
+		o.MaxBodySize = defaultMaxBodySize
+	}
+
+	if o.UserAgent == "" {
+		o.UserAgent = userAgent()
+	}
+
+	if o.Metrics == nil {
+		o.Metrics = nopMetrics{}
+	}
+
+	if o.Logger == nil {
+		o.Logger = zap.L()
+	}
+}
+
+// New returns HTTP client to egress, that all platform clients should use with Do.
+//
+// Client follows redirects, gzip responses are decompressed transparently
+// and response bodies bigger than Opts.MaxBodySize fail with ErrBodyTooLarge.
+func New(opts Opts) (*http.Client, error) {
+	opts.setDefaults()
+
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		transport = &http.Transport{}
+	}
+
+	transport = transport.Clone()
+	// gzip is requested and decoded by transport itself, when compression is not disabled.
+	transport.DisableCompression = false
+
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("url.Parse: %w", err)
+		}
+
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("%w: %s", errUnsupportedProxyScheme, proxyURL.Scheme)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &http.Client{
+		Transport: &roundTripper{
+			base:        transport,
+			userAgent:   opts.UserAgent,
+			maxBodySize: opts.MaxBodySize,
+			metrics:     opts.Metrics,
+			logger:      opts.Logger.Named("egress"),
+		},
+		Timeout: opts.Timeout,
+	}, nil
+}
+
+// Do sends request with client and returns responses with non-2xx status code
+// (except 304 Not Modified, see Cache) as *StatusError.
+func Do(client *http.Client, req *http.Request) (*http.Response, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		return resp, nil
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainSize)) //nolint:errcheck
+		_ = resp.Body.Close()                                               //nolint:errcheck
+
+		return nil, &StatusError{
+			StatusCode: resp.StatusCode,
+			URL:        req.URL.Redacted(),
+		}
+	}
+
+	return resp, nil
+}
+
+type roundTripper struct {
+	base        http.RoundTripper
+	userAgent   string
+	maxBodySize int64
+
+	metrics Metrics
+	logger  *zap.Logger
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		t.logger.Debug("upstream response is not modified, cached response is used", zap.String("url", req.URL.Redacted()))
+		t.metrics.RecordNotModified(req.URL.Host)
+	}
+
+	resp.Body = &limitedBody{
+		ReadCloser: resp.Body,
+		remaining:  t.maxBodySize,
+	}
+
+	return resp, nil
+}
+
+// limitedBody fails with ErrBodyTooLarge, when more than limit bytes are read.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ErrBodyTooLarge
+	}
+
+	// read one byte more than remaining, to detect overflow.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+
+	if b.remaining < 0 {
+		return n + int(b.remaining), ErrBodyTooLarge
+	}
+
+	return n, err
+}
+
+func userAgent() string {
+	version := "unknown"
+	if info := buildinfo.Get(); info != nil && info.Version != "" {
+		version = info.Version
+	}
+
+	return "gdatum/" + version
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) RecordNotModified(string) {}
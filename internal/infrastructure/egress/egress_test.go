@@ -0,0 +1,149 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package egress
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /user-agent", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.UserAgent())) //nolint:errcheck
+	})
+	mux.HandleFunc("GET /gzip", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+
+		gw := gzip.NewWriter(w)
+		_, _ = gw.Write([]byte("compressed")) //nolint:errcheck
+		_ = gw.Close()                        //nolint:errcheck
+	})
+	mux.HandleFunc("GET /large", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("a", 2048))) //nolint:errcheck
+	})
+	mux.HandleFunc("GET /slow", func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/user-agent", http.StatusFound)
+	})
+	mux.HandleFunc("GET /unavailable", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func get(t *testing.T, client *http.Client, url string) (string, error) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	resp, err := Do(client, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(resp.Body)
+
+	return string(body), err
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	client, err := New(Opts{
+		Timeout:     100 * time.Millisecond,
+		MaxBodySize: 1024,
+		UserAgent:   "gdatum/test",
+	})
+	require.NoError(t, err)
+
+	t.Run("UserAgent", func(t *testing.T) {
+		t.Parallel()
+
+		body, err := get(t, client, srv.URL+"/user-agent")
+		require.NoError(t, err)
+		assert.Equal(t, "gdatum/test", body)
+	})
+
+	t.Run("Gzip", func(t *testing.T) {
+		t.Parallel()
+
+		body, err := get(t, client, srv.URL+"/gzip")
+		require.NoError(t, err)
+		assert.Equal(t, "compressed", body)
+	})
+
+	t.Run("Redirect", func(t *testing.T) {
+		t.Parallel()
+
+		body, err := get(t, client, srv.URL+"/redirect")
+		require.NoError(t, err)
+		assert.Equal(t, "gdatum/test", body)
+	})
+
+	t.Run("BodyTooLarge", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := get(t, client, srv.URL+"/large")
+		assert.ErrorIs(t, err, ErrBodyTooLarge)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := get(t, client, srv.URL+"/slow")
+		assert.Error(t, err)
+	})
+
+	t.Run("StatusError", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := get(t, client, srv.URL+"/unavailable")
+
+		var statusErr *StatusError
+		require.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(Opts{ProxyURL: "socks5://127.0.0.1:1080"})
+	assert.NoError(t, err)
+
+	_, err = New(Opts{ProxyURL: "ftp://127.0.0.1:21"})
+	assert.ErrorIs(t, err, errUnsupportedProxyScheme)
+
+	client, err := New(Opts{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultTimeout, client.Timeout)
+}
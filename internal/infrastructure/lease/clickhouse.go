@@ -0,0 +1,90 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package lease
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"go.uber.org/zap"
+)
+
+const (
+	// claimsRetention is a time after which old claims are deleted.
+	claimsRetention = 24 * time.Hour
+
+	createClaimsTableQuery = `CREATE TABLE IF NOT EXISTS worker_leases
+(
+    key        String,
+    job        String,
+    holder     String,
+    claimed_at DateTime
+) ENGINE = KeeperMap('/gdatum/worker_leases') PRIMARY KEY key`
+
+	insertClaimQuery  = `INSERT INTO worker_leases (key, job, holder, claimed_at) VALUES (?, ?, ?, now())`
+	selectClaimQuery  = `SELECT holder FROM worker_leases WHERE key = ?`
+	deleteClaimsQuery = `DELETE FROM worker_leases WHERE job = ? AND claimed_at < now() - INTERVAL ? SECOND`
+)
+
+// ClickHouse is a lease for replicas sharing ClickHouse, claims are kept in KeeperMap table,
+// so ClickHouse must be configured with Keeper and keeper_map_path_prefix.
+type ClickHouse struct {
+	db     driver.Conn
+	holder string
+
+	logger *zap.Logger
+}
+
+// NewClickHouse returns new ClickHouse lease and creates its table, if it doesn't exist.
+// Table isn't created by migrations, because KeeperMap requires Keeper, that is needed only for this lease.
+func NewClickHouse(ctx context.Context, db driver.Conn, logger *zap.Logger) (*ClickHouse, error) {
+	if logger == nil {
+		logger = zap.L()
+	}
+
+	if err := db.Exec(ctx, createClaimsTableQuery); err != nil {
+		return nil, fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return &ClickHouse{
+		db:     db,
+		holder: holderID(),
+		logger: logger.Named("clickhouse-lease"),
+	}, nil
+}
+
+// Claim returns true, if tick of the job wasn't claimed by anyone before.
+func (c *ClickHouse) Claim(ctx context.Context, job string, tick time.Time) (bool, error) {
+	key := job + "/" + strconv.FormatInt(tick.Unix(), 10)
+
+	// strict mode fails insert of existing key, instead of overwriting it.
+	insertCtx := clickhouse.Context(ctx, clickhouse.WithSettings(clickhouse.Settings{
+		"keeper_map_strict_mode": 1,
+	}))
+
+	insertErr := c.db.Exec(insertCtx, insertClaimQuery, key, job, c.holder)
+	if insertErr == nil {
+		c.deleteOldClaims(ctx, job)
+		return true, nil
+	}
+
+	// insert may fail because key exists or because of any other error, so claim is checked to distinguish them.
+	var holder string
+	if err := c.db.QueryRow(ctx, selectClaimQuery, key).Scan(&holder); err != nil {
+		return false, errors.Join(fmt.Errorf("db.Exec: %w", insertErr), fmt.Errorf("db.QueryRow: %w", err))
+	}
+
+	return holder == c.holder, nil
+}
+
+func (c *ClickHouse) deleteOldClaims(ctx context.Context, job string) {
+	if err := c.db.Exec(ctx, deleteClaimsQuery, job, int(claimsRetention.Seconds())); err != nil {
+		c.logger.Warn("failed to delete old claims", zap.String("job", job), zap.Error(err))
+	}
+}
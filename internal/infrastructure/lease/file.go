@@ -0,0 +1,80 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+//go:build unix
+
+package lease
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// File is a lease for replicas on the same host, that share directory.
+// Every job has own file with the last claimed tick, that is changed under exclusive flock.
+type File struct {
+	dir string
+}
+
+// NewFile returns new File lease, that keeps claims in dir.
+func NewFile(dir string) (*File, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("os.MkdirAll: %w", err)
+	}
+
+	return &File{
+		dir: dir,
+	}, nil
+}
+
+// Claim returns true, if tick of the job wasn't claimed by anyone before.
+func (f *File) Claim(_ context.Context, job string, tick time.Time) (bool, error) {
+	file, err := os.OpenFile(filepath.Join(f.dir, url.PathEscape(job)+".lease"), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return false, fmt.Errorf("os.OpenFile: %w", err)
+	}
+
+	defer file.Close() //nolint:errcheck
+
+	fd := int(file.Fd()) //nolint:gosec
+
+	if err = unix.Flock(fd, unix.LOCK_EX); err != nil {
+		return false, fmt.Errorf("unix.Flock: %w", err)
+	}
+
+	defer unix.Flock(fd, unix.LOCK_UN) //nolint:errcheck
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return false, fmt.Errorf("io.ReadAll: %w", err)
+	}
+
+	if data = bytes.TrimSpace(data); len(data) > 0 {
+		lastTick, err := time.Parse(time.RFC3339Nano, string(data))
+		if err != nil {
+			return false, fmt.Errorf("time.Parse: %w", err)
+		}
+
+		if !tick.After(lastTick) {
+			return false, nil
+		}
+	}
+
+	if err = file.Truncate(0); err != nil {
+		return false, fmt.Errorf("file.Truncate: %w", err)
+	}
+
+	if _, err = file.WriteAt([]byte(tick.UTC().Format(time.RFC3339Nano)), 0); err != nil {
+		return false, fmt.Errorf("file.WriteAt: %w", err)
+	}
+
+	return true, nil
+}
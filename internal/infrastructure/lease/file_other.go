@@ -0,0 +1,27 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+//go:build !unix
+
+package lease
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var errFileUnsupported = errors.New("file lease is supported only on unix")
+
+// File is a lease for replicas on the same host, it isn't supported on this platform.
+type File struct{}
+
+// NewFile returns error, because flock isn't available on this platform.
+func NewFile(string) (*File, error) {
+	return nil, errFileUnsupported
+}
+
+// Claim ...
+func (*File) Claim(context.Context, string, time.Time) (bool, error) {
+	return false, errFileUnsupported
+}
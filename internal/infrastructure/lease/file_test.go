@@ -0,0 +1,93 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+//go:build unix
+
+package lease
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/EpicStep/gdatum/internal/infrastructure/worker"
+)
+
+func TestFileClaim(t *testing.T) {
+	t.Parallel()
+
+	lease, err := NewFile(t.TempDir())
+	require.NoError(t, err)
+
+	tick := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	claimed, err := lease.Claim(t.Context(), "job", tick)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = lease.Claim(t.Context(), "job", tick)
+	require.NoError(t, err)
+	assert.False(t, claimed, "same tick is claimed twice")
+
+	claimed, err = lease.Claim(t.Context(), "other-job", tick)
+	require.NoError(t, err)
+	assert.True(t, claimed, "ticks of different jobs are independent")
+
+	claimed, err = lease.Claim(t.Context(), "job", tick.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestFileWorkersCompete(t *testing.T) {
+	t.Parallel()
+
+	const interval = 100 * time.Millisecond
+
+	dir := t.TempDir()
+
+	var (
+		mu   sync.Mutex
+		runs = make(map[time.Time]int)
+	)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*interval)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	for range 2 {
+		// every replica has own lease instance, as separate processes would.
+		lease, err := NewFile(dir)
+		require.NoError(t, err)
+
+		w := worker.New("collector", func(_ context.Context, tick time.Time) error {
+			mu.Lock()
+			runs[tick]++
+			mu.Unlock()
+
+			return nil
+		}, worker.Opts{
+			Schedule: worker.Every(interval),
+			Lease:    lease,
+		})
+
+		wg.Go(func() {
+			assert.NoError(t, w.Run(ctx))
+		})
+	}
+
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.GreaterOrEqual(t, len(runs), 5)
+
+	for tick, count := range runs {
+		assert.Equal(t, 1, count, "tick %s was run %d times", tick, count)
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+// Package lease contains implementations of worker.Lease, that let only one of the replicas run every tick of the job.
+//
+// Leases claim ticks instead of electing long living leader, so replica that dies in the middle of the job
+// doesn't block others from running next ticks.
+package lease
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// holderID returns identifier of the current process, that is stored with the claim for debugging.
+func holderID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%s/%s", hostname, strconv.Itoa(os.Getpid()))
+}
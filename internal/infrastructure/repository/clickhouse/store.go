@@ -6,7 +6,10 @@ package clickhouse
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/huandu/go-sqlbuilder"
 
@@ -14,6 +17,10 @@ import (
 	"github.com/EpicStep/gdatum/internal/utils/sql"
 )
 
+// latestSnapshotMaxAge is a maximum age of the snapshot, that is treated as the latest one.
+// Multiplayers that were not collected for longer are treated as offline.
+const latestSnapshotMaxAge = 24 * time.Hour
+
 // Store ...
 type Store struct {
 	db driver.Conn
@@ -26,16 +33,26 @@ func New(db driver.Conn) *Store {
 	}
 }
 
-// InsertServers ...
-func (s *Store) InsertServers(ctx context.Context, servers []Server) error {
+// InsertServers inserts servers of the snapshot, repeated insert with the same snapshotID is deduplicated by ClickHouse.
+func (s *Store) InsertServers(ctx context.Context, snapshotID string, servers []Server) error {
 	if len(servers) == 0 {
 		return nil
 	}
 
+	// servers_metrics_raw is a Null table, so deduplication happens in tables of its materialized views.
+	ctx = clickhouse.Context(ctx, clickhouse.WithSettings(clickhouse.Settings{
+		"insert_deduplicate":                                 1,
+		"insert_deduplication_token":                         snapshotID,
+		"deduplicate_blocks_in_dependent_materialized_views": 1,
+	}))
+
 	ib := sqlbuilder.
 		NewInsertBuilder().
 		InsertInto(serversMetricsRawTableName).
-		Cols(multiplayerColumnName, hostColumnName, nameColumnName, languageColumnName, gamemodeColumnName, urlColumnName, playersCountColumnName, collectedAtColumnName)
+		Cols(
+			multiplayerColumnName, hostColumnName, nameColumnName, languageColumnName, gamemodeColumnName, urlColumnName, playersCountColumnName,
+			maxPlayersColumnName, peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName, collectedAtColumnName,
+		)
 
 	sqlRaw, _ := sql.Build(ib)
 
@@ -53,6 +70,12 @@ func (s *Store) InsertServers(ctx context.Context, servers []Server) error {
 			server.Gamemode,
 			server.URL,
 			server.PlayersCount,
+			server.MaxPlayers,
+			server.PeakPlayers,
+			server.Map,
+			server.Version,
+			server.Passworded,
+			server.Tags,
 			server.CollectedAt,
 		)
 		if err != nil {
@@ -71,12 +94,14 @@ func (s *Store) InsertServers(ctx context.Context, servers []Server) error {
 func (s *Store) ListMultiplayerSummaries(ctx context.Context, playersOrderAsc bool) ([]MultiplayerSummary, error) {
 	sb := sqlbuilder.NewSelectBuilder()
 
-	sb = sb.From(serversOnlineTableName).
+	sb = sb.From(finalTable(serversOnlineTableName)).
 		Select(multiplayerColumnName, sb.As(wrapColumn("sum", playersCountColumnName), playersCountColumnName)).
-		Where(fmt.Sprintf("%s = toStartOfHour(now())", collectedAtColumnName)).
+		Where(fmt.Sprintf("(%s, %s) IN (%s)", multiplayerColumnName, collectedAtColumnName, sb.Var(latestSnapshotsBuilder()))).
 		GroupBy(multiplayerColumnName)
 
-	if !playersOrderAsc {
+	if playersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
 		sb = sb.OrderByDesc(playersCountColumnName)
 	}
 
@@ -90,25 +115,74 @@ func (s *Store) ListMultiplayerSummaries(ctx context.Context, playersOrderAsc bo
 	return result, nil
 }
 
-// ListServerSummaries ...
+// ListMultiplayerStatistics reads multiplayer totals, that are summed per snapshot by materialized view.
+// Snapshot totals are averaged in the bucket, so buckets with several snapshots are not overcounted.
+func (s *Store) ListMultiplayerStatistics(ctx context.Context, params domain.ListMultiplayerStatisticsParams) ([]MultiplayerStatisticPoint, error) {
+	snapshots := sqlbuilder.NewSelectBuilder()
+
+	snapshots = snapshots.From(multiplayersOnlineTableName).
+		Select(
+			collectedAtColumnName,
+			snapshots.As(wrapColumn("sum", playersCountColumnName), snapshotPlayersAlias),
+			snapshots.As(wrapColumn("sum", serversCountColumnName), snapshotServersAlias),
+		).
+		Where(
+			snapshots.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			snapshots.GreaterThan(collectedAtColumnName, params.TimeRange.From),
+			snapshots.LessThan(collectedAtColumnName, params.TimeRange.To),
+		).
+		GroupBy(collectedAtColumnName)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(sb.BuilderAs(snapshots, "snapshots")).
+		Select(
+			sb.As(statisticsSourceOf(params.Precision).bucket(collectedAtColumnName), collectedAtColumnName),
+			sb.As(wrapColumn("toInt64", wrapColumn("avg", snapshotPlayersAlias)), playersCountColumnName),
+			sb.As(wrapColumn("toInt64", wrapColumn("avg", snapshotServersAlias)), serversCountColumnName),
+		).
+		GroupBy(collectedAtColumnName).
+		OrderByDesc(collectedAtColumnName)
+
+	sqlRaw, args := sql.Build(sb)
+
+	var result []MultiplayerStatisticPoint
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListServerSummaries returns servers of the latest snapshot, servers missing from it are returned only with params.IncludeOffline.
 func (s *Store) ListServerSummaries(ctx context.Context, params domain.ListServerSummariesParams) ([]ServerSummary, error) {
 	sb := sqlbuilder.NewSelectBuilder()
 
-	sb = sb.From(serversInfoTableName).
-		Select(hostColumnName, nameColumnName, playersCountColumnName).
+	sb = sb.From(finalTable(serversInfoTableName)).
+		Select(
+			sb.As(serversInfoTableName+"."+hostColumnName, hostColumnName),
+			nameColumnName,
+			sb.As(serversOnlineTableName+"."+playersCountColumnName, playersCountColumnName),
+			sb.As(onlineColumn(), onlineAlias),
+			sb.As(serversInfoTableName+"."+collectedAtColumnName, lastSeenAtAlias),
+		).
 		Where(sb.Equal(multiplayerColumnName, string(params.Multiplayer))).
 		JoinWithOption(
 			sqlbuilder.LeftJoin,
-			serversOnlineTableName,
-			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, multiplayerColumnName, serversOnlineTableName, multiplayerColumnName),
+			sb.BuilderAs(latestOnlineBuilder(params.Multiplayer), serversOnlineTableName),
 			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
-			fmt.Sprintf("%s.%s = toStartOfHour(now())", serversOnlineTableName, collectedAtColumnName),
 		).
-		OrderByDesc(collectedAtColumnName).
+		OrderByDesc(lastSeenAtAlias).
 		Limit(int(params.Limit)).
 		Offset(int(params.Offset))
 
-	if !params.PlayersOrderAsc {
+	if !params.IncludeOffline {
+		sb = sb.Where(onlineColumn())
+	}
+
+	if params.PlayersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
 		sb = sb.OrderByDesc(playersCountColumnName)
 	}
 
@@ -122,12 +196,209 @@ func (s *Store) ListServerSummaries(ctx context.Context, params domain.ListServe
 	return result, nil
 }
 
+// SearchServers returns page of servers found by params, ordered by current players count.
+func (s *Store) SearchServers(ctx context.Context, params domain.SearchServersParams) ([]ServerSummary, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(sb.BuilderAs(searchServersBuilder(params), "servers")).
+		Select(hostColumnName, nameColumnName, playersCountColumnName, onlineAlias, lastSeenAtAlias).
+		OrderByDesc(playersCountColumnName).
+		OrderByAsc(hostColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
+
+	sqlRaw, args := sql.Build(sb)
+
+	var result []ServerSummary
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListServerFacets returns counts of servers found by params per language and gamemode.
+func (s *Store) ListServerFacets(ctx context.Context, params domain.SearchServersParams) ([]ServerFacetValue, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(sb.BuilderAs(searchServersBuilder(params), "servers")).
+		Select(
+			sb.As("facets.1", facetAlias),
+			sb.As("facets.2", facetValueAlias),
+			sb.As("count()", facetCountAlias),
+		).
+		JoinWithOption(arrayJoin, fmt.Sprintf("[('%s', %s), ('%s', %s)] AS facets", FacetLanguage, languageColumnName, FacetGamemode, gamemodeColumnName)).
+		GroupBy(facetAlias, facetValueAlias).
+		OrderByDesc(facetCountAlias).
+		OrderByAsc(facetValueAlias)
+
+	sqlRaw, args := sql.Build(sb)
+
+	var result []ServerFacetValue
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListTrendingServers compares average players of servers in the window ending now with the previous window.
+func (s *Store) ListTrendingServers(ctx context.Context, params domain.ListTrendingServersParams) ([]TrendingServer, error) {
+	var (
+		to    = time.Now()
+		split = to.Add(-params.Window.Duration())
+		from  = split.Add(-params.Window.Duration())
+	)
+
+	trends := sqlbuilder.NewSelectBuilder()
+
+	// avgIf of window without samples is nan, so it is replaced by zero.
+	trends = trends.From(finalTable(serversOnlineTableName)).
+		Select(
+			hostColumnName,
+			trends.As(fmt.Sprintf("ifNotFinite(avgIf(%s, %s), 0)", playersCountColumnName, trends.LessThan(collectedAtColumnName, split)), playersBeforeAlias),
+			trends.As(fmt.Sprintf("ifNotFinite(avgIf(%s, %s), 0)", playersCountColumnName, trends.GreaterEqualThan(collectedAtColumnName, split)), playersAfterAlias),
+		).
+		Where(
+			trends.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			trends.GreaterEqualThan(collectedAtColumnName, from),
+			trends.LessThan(collectedAtColumnName, to),
+		).
+		GroupBy(hostColumnName)
+
+	names := sqlbuilder.NewSelectBuilder()
+
+	names = names.From(finalTable(serversInfoTableName)).
+		Select(hostColumnName, nameColumnName).
+		Where(names.Equal(multiplayerColumnName, string(params.Multiplayer)))
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	orderBy := playersChangeAlias
+	if params.OrderBy == domain.TrendingOrderRelative {
+		orderBy = relativeChangeAlias
+	}
+
+	sb = sb.From(sb.BuilderAs(trends, "trends")).
+		Select(
+			sb.As("trends."+hostColumnName, hostColumnName),
+			sb.As(serversInfoTableName+"."+nameColumnName, nameColumnName),
+			playersBeforeAlias,
+			playersAfterAlias,
+			sb.As(fmt.Sprintf("%s - %s", playersAfterAlias, playersBeforeAlias), playersChangeAlias),
+			sb.As(fmt.Sprintf("if(%s > 0, %s / %s, NULL)", playersBeforeAlias, playersChangeAlias, playersBeforeAlias), relativeChangeAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(names, serversInfoTableName),
+			fmt.Sprintf("trends.%s = %s.%s", hostColumnName, serversInfoTableName, hostColumnName),
+		).
+		OrderByDesc(orderBy).
+		OrderByAsc(hostColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
+
+	sqlRaw, args := sql.Build(sb)
+
+	var result []TrendingServer
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListServerSummariesPage returns servers ordered by players count and host, that are after params.After.
+func (s *Store) ListServerSummariesPage(ctx context.Context, params domain.ListServerSummariesPageParams) ([]ServerSummary, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	var (
+		hostColumn    = serversInfoTableName + "." + hostColumnName
+		playersColumn = serversOnlineTableName + "." + playersCountColumnName
+	)
+
+	sb = sb.From(finalTable(serversInfoTableName)).
+		Select(
+			sb.As(hostColumn, hostColumnName),
+			nameColumnName,
+			sb.As(playersColumn, playersCountColumnName),
+			sb.As(onlineColumn(), onlineAlias),
+			sb.As(serversInfoTableName+"."+collectedAtColumnName, lastSeenAtAlias),
+		).
+		Where(sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(params.Multiplayer))).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(latestOnlineBuilder(params.Multiplayer), serversOnlineTableName),
+			fmt.Sprintf("%s = %s.%s", hostColumn, serversOnlineTableName, hostColumnName),
+		).
+		Limit(int(params.Limit))
+
+	if !params.IncludeOffline {
+		sb = sb.Where(onlineColumn())
+	}
+
+	if params.After != nil {
+		playersAfter := sb.LessThan(playersColumn, params.After.PlayersCount)
+		if params.PlayersOrderAsc {
+			playersAfter = sb.GreaterThan(playersColumn, params.After.PlayersCount)
+		}
+
+		sb = sb.Where(sb.Or(
+			playersAfter,
+			sb.And(sb.Equal(playersColumn, params.After.PlayersCount), sb.GreaterThan(hostColumn, params.After.Host)),
+		))
+	}
+
+	if params.PlayersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
+		sb = sb.OrderByDesc(playersCountColumnName)
+	}
+
+	sb = sb.OrderByAsc(hostColumnName)
+
+	sqlRaw, args := sql.Build(sb)
+
+	var result []ServerSummary
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// CountServers returns count of servers of the multiplayer, servers missing from the latest snapshot are counted only with includeOffline.
+func (s *Store) CountServers(ctx context.Context, multiplayer domain.Multiplayer, includeOffline bool) (uint64, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	if includeOffline {
+		sb = sb.From(finalTable(serversInfoTableName)).
+			Select("count()").
+			Where(sb.Equal(multiplayerColumnName, string(multiplayer)))
+	} else {
+		sb = sb.From(sb.BuilderAs(latestOnlineBuilder(multiplayer), serversOnlineTableName)).
+			Select("count()")
+	}
+
+	sqlRaw, args := sql.Build(sb)
+
+	var count uint64
+	if err := s.db.QueryRow(ctx, sqlRaw, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("s.db.QueryRow: %w", err)
+	}
+
+	return count, nil
+}
+
 // GetServer ...
 func (s *Store) GetServer(ctx context.Context, multiplayer domain.Multiplayer, host string) (Server, error) {
 	sb := sqlbuilder.NewSelectBuilder()
 
-	sb = sb.From(serversInfoTableName).
-		Select(multiplayerColumnName, hostColumnName, nameColumnName, languageColumnName, gamemodeColumnName, urlColumnName, playersCountColumnName, collectedAtColumnName).
+	sb = sb.From(finalTable(serversInfoTableName)).
+		Select(
+			multiplayerColumnName, hostColumnName, nameColumnName, languageColumnName, gamemodeColumnName, urlColumnName, playersCountColumnName,
+			maxPlayersColumnName, peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName, collectedAtColumnName,
+		).
 		Where(
 			sb.And(
 				sb.Equal(multiplayerColumnName, multiplayer),
@@ -136,10 +407,8 @@ func (s *Store) GetServer(ctx context.Context, multiplayer domain.Multiplayer, h
 		).
 		JoinWithOption(
 			sqlbuilder.LeftJoin,
-			serversOnlineTableName,
-			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, multiplayerColumnName, serversOnlineTableName, multiplayerColumnName),
+			sb.BuilderAs(latestOnlineBuilder(multiplayer), serversOnlineTableName),
 			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
-			fmt.Sprintf("%s.%s = toStartOfHour(now())", serversOnlineTableName, collectedAtColumnName),
 		).OrderByDesc(collectedAtColumnName).Limit(1)
 
 	sqlRaw, args := sb.Build()
@@ -152,33 +421,322 @@ func (s *Store) GetServer(ctx context.Context, multiplayer domain.Multiplayer, h
 	return srv, nil
 }
 
-// ListServerStatistics ...
+// ListServerStatistics reads raw online for hourly and sub-hour precisions and rollups for longer ones.
+// When params.Fill is set, missing buckets of the time range are returned with Present = 0.
 func (s *Store) ListServerStatistics(ctx context.Context, params domain.ListServerStatisticsParams) ([]ServerStatisticPoint, error) {
 	sb := sqlbuilder.NewSelectBuilder()
 
-	timeSelect := wrapColumn("toStartOfHour", collectedAtColumnName)
-	if params.Precision == domain.ServerStatisticsPrecisionPerDay {
-		timeSelect = wrapColumn("toStartOfDay", collectedAtColumnName)
+	source := statisticsSourceOf(params.Precision)
+
+	var (
+		tableName = finalTable(serversOnlineTableName)
+		// avg is merged from rollup states, when rollup table is used.
+		playersSelect    = wrapColumn("avg", playersCountColumnName)
+		aggregateSelects = rawAggregateSelects
+		timeRangeConds   = []string{
+			sb.GreaterThan(collectedAtColumnName, params.TimeRange.From),
+			sb.LessThan(collectedAtColumnName, params.TimeRange.To),
+		}
+	)
+
+	if source.rollupTableName != "" {
+		tableName = source.rollupTableName
+		playersSelect = wrapColumn("avgMerge", playersAvgColumnName)
+		aggregateSelects = rollupAggregateSelects
+
+		// rollups are keyed by date, so the bucket of From is included. Column is qualified, to not be replaced by bucket alias.
+		rollupColumn := tableName + "." + collectedAtColumnName
+		timeRangeConds = []string{
+			fmt.Sprintf("%s >= %s", rollupColumn, source.bucket(fmt.Sprintf("toDateTime(%s)", sb.Var(params.TimeRange.From)))),
+			sb.LessThan(rollupColumn, params.TimeRange.To),
+		}
+	}
+
+	selects := []string{
+		sb.As(source.bucket(collectedAtColumnName), collectedAtColumnName),
+		sb.As(wrapColumn("toInt32", playersSelect), playersCountColumnName),
+		sb.As("toUInt8(1)", presentAlias),
+	}
+
+	for _, aggregate := range params.Aggregates {
+		if aggregateSelect, ok := aggregateSelects[aggregate]; ok {
+			selects = append(selects, aggregateSelect)
+		}
 	}
 
 	sb = sb.
-		From(serversOnlineTableName).
+		From(tableName).
+		Select(selects...).
+		Where(
+			append([]string{
+				sb.Equal(multiplayerColumnName, string(params.Multiplayer)),
+				sb.Equal(hostColumnName, params.Host),
+			}, timeRangeConds...)...,
+		).
+		GroupBy(collectedAtColumnName)
+
+	var builder sqlbuilder.Builder
+
+	if !params.Fill {
+		builder = sb.OrderByDesc(collectedAtColumnName)
+	} else {
+		// WITH FILL is applied in ascending order, so filled series is sorted again.
+		sb = sb.OrderBy(fmt.Sprintf("%s WITH FILL FROM %s TO toDateTime(%s) STEP %s",
+			collectedAtColumnName,
+			source.bucket(fmt.Sprintf("toDateTime(%s)", sb.Var(params.TimeRange.From))),
+			sb.Var(params.TimeRange.To),
+			source.step,
+		))
+
+		filled := sqlbuilder.NewSelectBuilder()
+		builder = filled.Select("*").From(filled.BuilderAs(sb, "points")).OrderByDesc(collectedAtColumnName)
+	}
+
+	sqlRaw, args := sql.Build(builder)
+
+	var result []ServerStatisticPoint
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListCollectionRunBuckets returns buckets of the time range, that have collection runs of the multiplayer.
+func (s *Store) ListCollectionRunBuckets(ctx context.Context, params domain.ListServerStatisticsParams) ([]CollectionRunBucket, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	source := statisticsSourceOf(params.Precision)
+
+	sb = sb.From(finalTable(collectionRunsTableName)).
+		Select(
+			sb.As(source.bucket(collectedAtColumnName), collectedAtColumnName),
+			sb.As(fmt.Sprintf("max(%s = '%s')", statusColumnName, domain.CollectionRunStatusOK), succeededAlias),
+		).
+		Where(
+			sb.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			fmt.Sprintf("%s.%s >= %s",
+				collectionRunsTableName, collectedAtColumnName, source.bucket(fmt.Sprintf("toDateTime(%s)", sb.Var(params.TimeRange.From))),
+			),
+			sb.LessThan(collectionRunsTableName+"."+collectedAtColumnName, params.TimeRange.To),
+		).
+		GroupBy(collectedAtColumnName)
+
+	sqlRaw, args := sql.Build(sb)
+
+	var result []CollectionRunBucket
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetServerSeen returns the first and the last time, that server was present in snapshots.
+func (s *Store) GetServerSeen(ctx context.Context, multiplayer domain.Multiplayer, host string) (ServerSeen, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	// columns are qualified, so they are not resolved to aliases of the same name.
+	sb = sb.From(serversSeenTableName).
 		Select(
-			sb.As(timeSelect, collectedAtColumnName),
-			sb.As(wrapColumn("toInt32", wrapColumn("avg", playersCountColumnName)), playersCountColumnName),
+			sb.As(wrapColumn("min", serversSeenTableName+"."+firstSeenAtColumnName), firstSeenAtColumnName),
+			sb.As(wrapColumn("max", serversSeenTableName+"."+lastSeenAtColumnName), lastSeenAtColumnName),
 		).
+		Where(
+			sb.Equal(multiplayerColumnName, string(multiplayer)),
+			sb.Equal(hostColumnName, host),
+		).
+		GroupBy(multiplayerColumnName, hostColumnName)
+
+	sqlRaw, args := sql.Build(sb)
+
+	var seen ServerSeen
+	if err := s.db.QueryRow(ctx, sqlRaw, args...).ScanStruct(&seen); err != nil {
+		return ServerSeen{}, fmt.Errorf("s.db.QueryRow: %w", err)
+	}
+
+	return seen, nil
+}
+
+// ListServerRunPresence returns successful collection runs of the multiplayer since from ordered by time,
+// with presence of the server in them.
+func (s *Store) ListServerRunPresence(ctx context.Context, multiplayer domain.Multiplayer, host string, from time.Time) ([]ServerRunPresence, error) {
+	online := sqlbuilder.NewSelectBuilder()
+
+	online = online.From(finalTable(serversOnlineTableName)).
+		Select(hostColumnName, collectedAtColumnName).
+		Where(
+			online.Equal(multiplayerColumnName, string(multiplayer)),
+			online.Equal(hostColumnName, host),
+			online.GreaterEqualThan(collectedAtColumnName, from),
+		)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(finalTable(collectionRunsTableName)).
+		Select(
+			sb.As(collectionRunsTableName+"."+collectedAtColumnName, collectedAtColumnName),
+			sb.As(onlineColumn(), presentAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(online, serversOnlineTableName),
+			fmt.Sprintf("%s.%s = %s.%s", collectionRunsTableName, collectedAtColumnName, serversOnlineTableName, collectedAtColumnName),
+		).
+		Where(
+			sb.Equal(collectionRunsTableName+"."+multiplayerColumnName, string(multiplayer)),
+			sb.Equal(collectionRunsTableName+"."+statusColumnName, string(domain.CollectionRunStatusOK)),
+			sb.GreaterEqualThan(collectionRunsTableName+"."+collectedAtColumnName, from),
+		).
+		OrderByAsc(collectedAtColumnName)
+
+	sqlRaw, args := sql.Build(sb)
+
+	var result []ServerRunPresence
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// InsertCollectionRun ...
+func (s *Store) InsertCollectionRun(ctx context.Context, run CollectionRun) error {
+	ib := sqlbuilder.NewInsertBuilder()
+
+	ib = ib.InsertInto(collectionRunsTableName).
+		Cols(multiplayerColumnName, collectedAtColumnName, statusColumnName, serversCountColumnName, errorColumnName).
+		Values(run.Multiplayer, run.CollectedAt, run.Status, run.ServersCount, run.Error)
+
+	sqlRaw, args := sql.Build(ib)
+
+	if err := s.db.Exec(ctx, sqlRaw, args...); err != nil {
+		return fmt.Errorf("s.db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// statisticsSource describes where and how statistics of the precision are read.
+type statisticsSource struct {
+	// rollupTableName is empty, when raw online is read.
+	rollupTableName string
+	// bucket returns expression of the bucket start, it accepts both Date and DateTime.
+	bucket func(expr string) string
+	// step is a WITH FILL step between buckets.
+	step string
+}
+
+func statisticsSourceOf(precision domain.ServerStatisticsPrecision) statisticsSource {
+	switch precision {
+	case domain.ServerStatisticsPrecisionPerFiveMinutes:
+		return statisticsSource{
+			bucket: func(expr string) string { return wrapColumn("toStartOfFiveMinutes", expr) },
+			step:   "INTERVAL 5 MINUTE",
+		}
+	case domain.ServerStatisticsPrecisionPerFifteenMinutes:
+		return statisticsSource{
+			bucket: func(expr string) string { return wrapColumn("toStartOfFifteenMinutes", expr) },
+			step:   "INTERVAL 15 MINUTE",
+		}
+	case domain.ServerStatisticsPrecisionPerDay:
+		return statisticsSource{
+			rollupTableName: serversOnlineDailyTableName,
+			bucket:          func(expr string) string { return wrapColumn("toDateTime", wrapColumn("toDate", expr)) },
+			step:            "INTERVAL 1 DAY",
+		}
+	case domain.ServerStatisticsPrecisionPerWeek:
+		return statisticsSource{
+			rollupTableName: serversOnlineDailyTableName,
+			bucket:          func(expr string) string { return wrapColumn("toDateTime", fmt.Sprintf("toStartOfWeek(%s, 1)", expr)) },
+			step:            "INTERVAL 1 WEEK",
+		}
+	case domain.ServerStatisticsPrecisionPerMonth:
+		return statisticsSource{
+			rollupTableName: serversOnlineMonthlyTableName,
+			bucket:          func(expr string) string { return wrapColumn("toDateTime", wrapColumn("toStartOfMonth", expr)) },
+			step:            "INTERVAL 1 MONTH",
+		}
+	default:
+		return statisticsSource{
+			bucket: func(expr string) string { return wrapColumn("toStartOfHour", expr) },
+			step:   "INTERVAL 1 HOUR",
+		}
+	}
+}
+
+// ListServerMetadata returns metadata of all servers of the multiplayer.
+func (s *Store) ListServerMetadata(ctx context.Context, multiplayer domain.Multiplayer) ([]Server, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(finalTable(serversInfoTableName)).
+		Select(multiplayerColumnName, hostColumnName, nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName).
+		Where(sb.Equal(multiplayerColumnName, string(multiplayer)))
+
+	sqlRaw, args := sql.Build(sb)
+
+	var result []Server
+	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
+		return nil, fmt.Errorf("s.db.Select: %w", err)
+	}
+
+	return result, nil
+}
+
+// InsertServerChanges inserts changes detected by the snapshot, repeated insert with the same snapshotID is deduplicated by ClickHouse.
+func (s *Store) InsertServerChanges(ctx context.Context, snapshotID string, changes []ServerChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	ctx = clickhouse.Context(ctx, clickhouse.WithSettings(clickhouse.Settings{
+		"insert_deduplicate":         1,
+		"insert_deduplication_token": snapshotID,
+	}))
+
+	ib := sqlbuilder.
+		NewInsertBuilder().
+		InsertInto(serversHistoryTableName).
+		Cols(multiplayerColumnName, hostColumnName, fieldColumnName, oldValueColumnName, newValueColumnName, changedAtColumnName)
+
+	sqlRaw, _ := sql.Build(ib)
+
+	batch, err := s.db.PrepareBatch(ctx, sqlRaw)
+	if err != nil {
+		return fmt.Errorf("s.db.PrepareBatch: %w", err)
+	}
+
+	for _, change := range changes {
+		if err = batch.AppendStruct(&change); err != nil {
+			return fmt.Errorf("batch.AppendStruct: %w", err)
+		}
+	}
+
+	if err = batch.Send(); err != nil {
+		return fmt.Errorf("batch.Send: %w", err)
+	}
+
+	return nil
+}
+
+// ListServerChanges returns changes of the server, the newest first.
+func (s *Store) ListServerChanges(ctx context.Context, params domain.ListServerChangesParams) ([]ServerChange, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversHistoryTableName).
+		Select(multiplayerColumnName, hostColumnName, fieldColumnName, oldValueColumnName, newValueColumnName, changedAtColumnName).
 		Where(
 			sb.Equal(multiplayerColumnName, string(params.Multiplayer)),
 			sb.Equal(hostColumnName, params.Host),
-			sb.GreaterThan(collectedAtColumnName, params.TimeRange.From),
-			sb.LessThan(collectedAtColumnName, params.TimeRange.To),
 		).
-		GroupBy(collectedAtColumnName).
-		OrderByDesc(collectedAtColumnName)
+		OrderByDesc(changedAtColumnName).
+		OrderByAsc(fieldColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
 
 	sqlRaw, args := sql.Build(sb)
 
-	var result []ServerStatisticPoint
+	var result []ServerChange
 	if err := s.db.Select(ctx, &result, sqlRaw, args...); err != nil {
 		return nil, fmt.Errorf("s.db.Select: %w", err)
 	}
@@ -186,6 +744,151 @@ func (s *Store) ListServerStatistics(ctx context.Context, params domain.ListServ
 	return result, nil
 }
 
+var (
+	// column is qualified, because players_count alias of average shadows it.
+	rawAggregateSelects = map[domain.ServerStatisticAggregate]string{
+		domain.ServerStatisticAggregateMin:   "min(servers_online.players_count) AS " + minPlayersCountAlias,
+		domain.ServerStatisticAggregateMax:   "max(servers_online.players_count) AS " + maxPlayersCountAlias,
+		domain.ServerStatisticAggregateP50:   "toInt32(quantile(0.5)(servers_online.players_count)) AS " + p50PlayersCountAlias,
+		domain.ServerStatisticAggregateP95:   "toInt32(quantile(0.95)(servers_online.players_count)) AS " + p95PlayersCountAlias,
+		domain.ServerStatisticAggregateCount: "count() AS " + samplesCountAlias,
+	}
+	rollupAggregateSelects = map[domain.ServerStatisticAggregate]string{
+		domain.ServerStatisticAggregateMin:   "minMerge(players_min) AS " + minPlayersCountAlias,
+		domain.ServerStatisticAggregateMax:   "maxMerge(players_max) AS " + maxPlayersCountAlias,
+		domain.ServerStatisticAggregateP50:   "toInt32(quantilesMerge(0.5, 0.9, 0.99)(players_quantiles)[1]) AS " + p50PlayersCountAlias,
+		domain.ServerStatisticAggregateP95:   "toInt32(quantileMerge(0.95)(players_p95)) AS " + p95PlayersCountAlias,
+		domain.ServerStatisticAggregateCount: "sum(samples) AS " + samplesCountAlias,
+	}
+)
+
+// latestSnapshotsBuilder returns query of the latest snapshot time of every multiplayer.
+func latestSnapshotsBuilder() *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	return sb.From(serversOnlineTableName).
+		Select(multiplayerColumnName, wrapColumn("max", collectedAtColumnName)).
+		Where(latestSnapshotAgeCond()).
+		GroupBy(multiplayerColumnName)
+}
+
+// latestOnlineBuilder returns query of servers online of the multiplayer in its latest snapshot.
+func latestOnlineBuilder(multiplayer domain.Multiplayer) *sqlbuilder.SelectBuilder {
+	snapshot := sqlbuilder.NewSelectBuilder()
+	snapshot = snapshot.From(serversOnlineTableName).
+		Select(wrapColumn("max", collectedAtColumnName)).
+		Where(
+			snapshot.Equal(multiplayerColumnName, string(multiplayer)),
+			latestSnapshotAgeCond(),
+		)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	return sb.From(finalTable(serversOnlineTableName)).
+		Select(hostColumnName, playersCountColumnName).
+		Where(
+			sb.Equal(multiplayerColumnName, string(multiplayer)),
+			fmt.Sprintf("%s = (%s)", collectedAtColumnName, sb.Var(snapshot)),
+		)
+}
+
+// searchServersBuilder returns query of servers found by params with their current players count.
+// Name is matched by LIKE on lowerUTF8(name), that uses ngram and token bloom filter indexes of servers_info.
+func searchServersBuilder(params domain.SearchServersParams) *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	conds := []string{sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(params.Multiplayer))}
+
+	if metadataConds := searchMetadataConds(sb, serversInfoTableName+".", params); len(metadataConds) > 0 {
+		// skip indexes are not used with FINAL, so hosts are prefiltered by them without FINAL,
+		// then conditions are checked again on the latest version of the server.
+		hosts := sqlbuilder.NewSelectBuilder()
+		hosts = hosts.From(serversInfoTableName).
+			Select(hostColumnName).
+			Where(append([]string{hosts.Equal(multiplayerColumnName, string(params.Multiplayer))}, searchMetadataConds(hosts, "", params)...)...)
+
+		conds = append(conds, fmt.Sprintf("%s.%s IN (%s)", serversInfoTableName, hostColumnName, sb.Var(hosts)))
+		conds = append(conds, metadataConds...)
+	}
+
+	if params.MinPlayers != nil {
+		conds = append(conds, sb.GreaterEqualThan(serversOnlineTableName+"."+playersCountColumnName, *params.MinPlayers))
+	}
+
+	if params.MaxPlayers != nil {
+		conds = append(conds, sb.LessEqualThan(serversOnlineTableName+"."+playersCountColumnName, *params.MaxPlayers))
+	}
+
+	switch params.Online {
+	case domain.ServerOnlineFilterOnline:
+		conds = append(conds, onlineColumn())
+	case domain.ServerOnlineFilterOffline:
+		conds = append(conds, "NOT "+onlineColumn())
+	case domain.ServerOnlineFilterAny:
+	}
+
+	return sb.From(finalTable(serversInfoTableName)).
+		Select(
+			sb.As(serversInfoTableName+"."+hostColumnName, hostColumnName),
+			sb.As(serversInfoTableName+"."+nameColumnName, nameColumnName),
+			sb.As(serversInfoTableName+"."+languageColumnName, languageColumnName),
+			sb.As(serversInfoTableName+"."+gamemodeColumnName, gamemodeColumnName),
+			sb.As(serversOnlineTableName+"."+playersCountColumnName, playersCountColumnName),
+			sb.As(onlineColumn(), onlineAlias),
+			sb.As(serversInfoTableName+"."+collectedAtColumnName, lastSeenAtAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(latestOnlineBuilder(params.Multiplayer), serversOnlineTableName),
+			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
+		).
+		Where(conds...)
+}
+
+// searchMetadataConds returns conditions of params on servers_info columns, that are prefixed with prefix.
+func searchMetadataConds(sb *sqlbuilder.SelectBuilder, prefix string, params domain.SearchServersParams) []string {
+	var conds []string
+
+	if params.Query != "" {
+		conds = append(conds, sb.Like(wrapColumn("lowerUTF8", prefix+nameColumnName), "%"+escapeLike(strings.ToLower(params.Query))+"%"))
+	}
+
+	if params.Language != "" {
+		conds = append(conds, sb.Equal(prefix+languageColumnName, params.Language))
+	}
+
+	if params.Gamemode != "" {
+		conds = append(conds, sb.Equal(prefix+gamemodeColumnName, params.Gamemode))
+	}
+
+	return conds
+}
+
+// onlineColumn returns condition, whether server is present in the joined servers_online.
+// Servers missing from it have empty host after LEFT JOIN.
+func onlineColumn() string {
+	return fmt.Sprintf("%s.%s != ''", serversOnlineTableName, hostColumnName)
+}
+
+// arrayJoin is a ClickHouse ARRAY JOIN, that is not provided by sqlbuilder.
+const arrayJoin sqlbuilder.JoinOption = "ARRAY"
+
+// escapeLike escapes special characters of LIKE pattern.
+func escapeLike(value string) string {
+	return likeReplacer.Replace(value)
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func latestSnapshotAgeCond() string {
+	return fmt.Sprintf("%s >= now() - INTERVAL %d SECOND", collectedAtColumnName, int(latestSnapshotMaxAge.Seconds()))
+}
+
+// finalTable returns table with FINAL modifier, that collapses rows not yet merged by ReplacingMergeTree.
+func finalTable(tableName string) string {
+	return tableName + " FINAL"
+}
+
 func wrapColumn(wrapper, columnName string) string {
 	return wrapper + "(" + columnName + ")"
 }
@@ -9,6 +9,13 @@ const (
 	serversMetricsRawTableName = "servers_metrics_raw"
 	serversInfoTableName       = "servers_info"
 	serversOnlineTableName     = "servers_online"
+	serversHistoryTableName    = "servers_history"
+
+	collectionRunsTableName       = "collection_runs"
+	multiplayersOnlineTableName   = "multiplayers_online"
+	serversOnlineDailyTableName   = "servers_online_daily"
+	serversOnlineMonthlyTableName = "servers_online_monthly"
+	serversSeenTableName          = "servers_seen"
 
 	multiplayerColumnName  = "multiplayer"
 	hostColumnName         = "host"
@@ -17,7 +24,42 @@ const (
 	gamemodeColumnName     = "gamemode"
 	urlColumnName          = "url"
 	playersCountColumnName = "players_count"
+	maxPlayersColumnName   = "max_players"
+	peakPlayersColumnName  = "peak_players"
+	mapColumnName          = "map"
+	versionColumnName      = "version"
+	passwordedColumnName   = "passworded"
+	tagsColumnName         = "tags"
 	collectedAtColumnName  = "collected_at"
+	fieldColumnName        = "field"
+	oldValueColumnName     = "old_value"
+	newValueColumnName     = "new_value"
+	changedAtColumnName    = "changed_at"
+	playersAvgColumnName   = "players_avg"
+	statusColumnName       = "status"
+	serversCountColumnName = "servers_count"
+	errorColumnName        = "error"
+	firstSeenAtColumnName  = "first_seen_at"
+	lastSeenAtColumnName   = "last_seen_at"
+
+	minPlayersCountAlias = "min_players_count"
+	maxPlayersCountAlias = "max_players_count"
+	p50PlayersCountAlias = "p50_players_count"
+	p95PlayersCountAlias = "p95_players_count"
+	samplesCountAlias    = "samples_count"
+	presentAlias         = "present"
+	snapshotPlayersAlias = "snapshot_players_count"
+	snapshotServersAlias = "snapshot_servers_count"
+	succeededAlias       = "succeeded"
+	playersBeforeAlias   = "players_before"
+	playersAfterAlias    = "players_after"
+	playersChangeAlias   = "players_change"
+	relativeChangeAlias  = "relative_change"
+	facetAlias           = "facet"
+	facetValueAlias      = "value"
+	facetCountAlias      = "count"
+	onlineAlias          = "online"
+	lastSeenAtAlias      = "last_seen_at"
 )
 
 // Server ...
@@ -29,6 +71,12 @@ type Server struct {
 	Gamemode     string    `ch:"gamemode"`
 	Language     string    `ch:"language"`
 	PlayersCount int32     `ch:"players_count"`
+	MaxPlayers   int32     `ch:"max_players"`
+	PeakPlayers  int32     `ch:"peak_players"`
+	Map          string    `ch:"map"`
+	Version      string    `ch:"version"`
+	Passworded   *bool     `ch:"passworded"`
+	Tags         []string  `ch:"tags"`
 	CollectedAt  time.Time `ch:"collected_at"`
 }
 
@@ -40,13 +88,89 @@ type MultiplayerSummary struct {
 
 // ServerStatisticPoint ...
 type ServerStatisticPoint struct {
-	PlayersCount int32     `ch:"players_count"`
+	PlayersCount    int32     `ch:"players_count"`
+	MinPlayersCount int32     `ch:"min_players_count"`
+	MaxPlayersCount int32     `ch:"max_players_count"`
+	P50PlayersCount int32     `ch:"p50_players_count"`
+	P95PlayersCount int32     `ch:"p95_players_count"`
+	SamplesCount    uint64    `ch:"samples_count"`
+	Present         uint8     `ch:"present"`
+	CollectedAt     time.Time `ch:"collected_at"`
+}
+
+// TrendingServer ...
+type TrendingServer struct {
+	Host           string   `ch:"host"`
+	Name           string   `ch:"name"`
+	PlayersBefore  float64  `ch:"players_before"`
+	PlayersAfter   float64  `ch:"players_after"`
+	PlayersChange  float64  `ch:"players_change"`
+	RelativeChange *float64 `ch:"relative_change"`
+}
+
+// MultiplayerStatisticPoint ...
+type MultiplayerStatisticPoint struct {
+	PlayersCount int64     `ch:"players_count"`
+	ServersCount int64     `ch:"servers_count"`
 	CollectedAt  time.Time `ch:"collected_at"`
 }
 
+// CollectionRun ...
+type CollectionRun struct {
+	Multiplayer  string
+	CollectedAt  time.Time
+	Status       string
+	ServersCount int32
+	Error        string
+}
+
+// CollectionRunBucket ...
+type CollectionRunBucket struct {
+	CollectedAt time.Time `ch:"collected_at"`
+	Succeeded   bool      `ch:"succeeded"`
+}
+
 // ServerSummary ...
+// LastSeenAt is a time of the latest snapshot, that server was present in.
 type ServerSummary struct {
-	Host         string `ch:"host"`
-	Name         string `ch:"name"`
-	PlayersCount int32  `ch:"players_count"`
+	Host         string    `ch:"host"`
+	Name         string    `ch:"name"`
+	PlayersCount int32     `ch:"players_count"`
+	Online       bool      `ch:"online"`
+	LastSeenAt   time.Time `ch:"last_seen_at"`
+}
+
+// Facets of ServerFacetValue.
+const (
+	FacetLanguage = "language"
+	FacetGamemode = "gamemode"
+)
+
+// ServerFacetValue ...
+type ServerFacetValue struct {
+	Facet string `ch:"facet"`
+	Value string `ch:"value"`
+	Count uint64 `ch:"count"`
+}
+
+// ServerChange ...
+type ServerChange struct {
+	Multiplayer string    `ch:"multiplayer"`
+	Host        string    `ch:"host"`
+	Field       string    `ch:"field"`
+	OldValue    string    `ch:"old_value"`
+	NewValue    string    `ch:"new_value"`
+	ChangedAt   time.Time `ch:"changed_at"`
+}
+
+// ServerSeen ...
+type ServerSeen struct {
+	FirstSeenAt time.Time `ch:"first_seen_at"`
+	LastSeenAt  time.Time `ch:"last_seen_at"`
+}
+
+// ServerRunPresence ...
+type ServerRunPresence struct {
+	CollectedAt time.Time `ch:"collected_at"`
+	Present     bool      `ch:"present"`
 }
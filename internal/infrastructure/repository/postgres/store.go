@@ -0,0 +1,825 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/lo"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/utils/sql"
+)
+
+const (
+	// latestSnapshotMaxAge is a maximum age of the snapshot, that is treated as the latest one.
+	// Multiplayers that were not collected for longer are treated as offline.
+	latestSnapshotMaxAge = 24 * time.Hour
+
+	// insertChunkSize keeps inserts below the limit of bind parameters of a single statement.
+	insertChunkSize = 1000
+)
+
+// conn is a subset of pgxpool.Pool, that is used by Store.
+type conn interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// Store ...
+type Store struct {
+	db conn
+
+	timescaleDB bool
+}
+
+// Opts ...
+type Opts struct {
+	// TimescaleDB enables reading of continuous aggregates, that are created by TimescaleDB migrations.
+	TimescaleDB bool
+}
+
+// New ...
+func New(db *pgxpool.Pool, opts Opts) *Store {
+	return &Store{
+		db: db,
+
+		timescaleDB: opts.TimescaleDB,
+	}
+}
+
+// InsertServers inserts servers of the snapshot, repeated insert of the same snapshot is ignored by primary key.
+func (s *Store) InsertServers(ctx context.Context, servers []Server) error {
+	if len(servers) == 0 {
+		return nil
+	}
+
+	// upsert can not update the same row twice in a single statement.
+	servers = lo.UniqBy(servers, func(server Server) string {
+		return server.Multiplayer + "\x00" + server.Host
+	})
+
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		for _, chunk := range lo.Chunk(servers, insertChunkSize) {
+			online := sqlbuilder.NewInsertBuilder().
+				InsertInto(serversOnlineTableName).
+				Cols(multiplayerColumnName, hostColumnName, playersCountColumnName, collectedAtColumnName)
+
+			info := sqlbuilder.NewInsertBuilder().
+				InsertInto(serversInfoTableName).
+				Cols(
+					multiplayerColumnName, hostColumnName, nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName,
+					maxPlayersColumnName, peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName, collectedAtColumnName,
+				)
+
+			for _, server := range chunk {
+				online.Values(server.Multiplayer, server.Host, server.PlayersCount, server.CollectedAt)
+				info.Values(
+					server.Multiplayer, server.Host, server.Name, server.URL, server.Gamemode, server.Language,
+					server.MaxPlayers, server.PeakPlayers, server.Map, server.Version, server.Passworded, lo.CoalesceSliceOrEmpty(server.Tags), server.CollectedAt,
+				)
+			}
+
+			online.SQL("ON CONFLICT DO NOTHING")
+
+			// info of older snapshot, e.g. inserted by retry, does not override newer one.
+			info.SQL(fmt.Sprintf("ON CONFLICT (%s, %s) DO UPDATE SET %s WHERE %s.%s <= excluded.%s",
+				multiplayerColumnName, hostColumnName,
+				strings.Join(lo.Map([]string{
+					nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName, maxPlayersColumnName,
+					peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName, collectedAtColumnName,
+				}, func(column string, _ int) string {
+					return column + " = excluded." + column
+				}), ", "),
+				serversInfoTableName, collectedAtColumnName, collectedAtColumnName,
+			))
+
+			for _, ib := range []*sqlbuilder.InsertBuilder{online, info} {
+				sqlRaw, args := sql.BuildPostgreSQL(ib)
+
+				if _, err := tx.Exec(ctx, sqlRaw, args...); err != nil {
+					return fmt.Errorf("tx.Exec: %w", err)
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("pgx.BeginFunc: %w", err)
+	}
+
+	return nil
+}
+
+// ListMultiplayerSummaries ...
+func (s *Store) ListMultiplayerSummaries(ctx context.Context, playersOrderAsc bool) ([]MultiplayerSummary, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversOnlineTableName).
+		Select(multiplayerColumnName, sb.As(fmt.Sprintf("sum(%s)::bigint", playersCountColumnName), playersCountColumnName)).
+		Where(fmt.Sprintf("(%s, %s) IN (%s)", multiplayerColumnName, collectedAtColumnName, sb.Var(latestSnapshotsBuilder()))).
+		GroupBy(multiplayerColumnName)
+
+	if playersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
+		sb = sb.OrderByDesc(playersCountColumnName)
+	}
+
+	return selectRows[MultiplayerSummary](ctx, s.db, sb)
+}
+
+// ListMultiplayerStatistics reads multiplayer totals, that are summed per snapshot and averaged in the bucket.
+func (s *Store) ListMultiplayerStatistics(ctx context.Context, params domain.ListMultiplayerStatisticsParams) ([]MultiplayerStatisticPoint, error) {
+	snapshots := sqlbuilder.NewSelectBuilder()
+
+	snapshots = snapshots.From(serversOnlineTableName).
+		Select(
+			collectedAtColumnName,
+			snapshots.As(wrapColumn("sum", playersCountColumnName), playersCountColumnName),
+			snapshots.As("count(*)", serversCountColumnName),
+		).
+		Where(
+			snapshots.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			snapshots.GreaterThan(collectedAtColumnName, params.TimeRange.From),
+			snapshots.LessThan(collectedAtColumnName, params.TimeRange.To),
+		).
+		GroupBy(collectedAtColumnName)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(sb.BuilderAs(snapshots, "snapshots")).
+		Select(
+			sb.As(bucketOf(params.Precision, collectedAtColumnName), collectedAtColumnName),
+			sb.As(fmt.Sprintf("trunc(avg(%s))::bigint", playersCountColumnName), playersCountColumnName),
+			sb.As(fmt.Sprintf("trunc(avg(%s))::bigint", serversCountColumnName), serversCountColumnName),
+		).
+		GroupBy("1").
+		OrderByDesc(collectedAtColumnName)
+
+	return selectRows[MultiplayerStatisticPoint](ctx, s.db, sb)
+}
+
+// ListServerSummaries returns servers of the latest snapshot, servers missing from it are returned only with params.IncludeOffline.
+func (s *Store) ListServerSummaries(ctx context.Context, params domain.ListServerSummariesParams) ([]ServerSummary, error) {
+	sb := serverSummariesBuilder(params.Multiplayer, params.IncludeOffline)
+
+	sb = sb.OrderByDesc(lastSeenAtAlias)
+
+	if params.PlayersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
+		sb = sb.OrderByDesc(playersCountColumnName)
+	}
+
+	sb = sb.Limit(int(params.Limit)).Offset(int(params.Offset))
+
+	return selectRows[ServerSummary](ctx, s.db, sb)
+}
+
+// ListServerSummariesPage returns servers ordered by players count and host, that are after params.After.
+func (s *Store) ListServerSummariesPage(ctx context.Context, params domain.ListServerSummariesPageParams) ([]ServerSummary, error) {
+	sb := serverSummariesBuilder(params.Multiplayer, params.IncludeOffline)
+
+	var (
+		hostColumn    = serversInfoTableName + "." + hostColumnName
+		playersColumn = onlinePlayersColumn()
+	)
+
+	if params.After != nil {
+		playersAfter := sb.LessThan(playersColumn, params.After.PlayersCount)
+		if params.PlayersOrderAsc {
+			playersAfter = sb.GreaterThan(playersColumn, params.After.PlayersCount)
+		}
+
+		sb = sb.Where(sb.Or(
+			playersAfter,
+			sb.And(sb.Equal(playersColumn, params.After.PlayersCount), sb.GreaterThan(hostColumn, params.After.Host)),
+		))
+	}
+
+	if params.PlayersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
+		sb = sb.OrderByDesc(playersCountColumnName)
+	}
+
+	sb = sb.OrderByAsc(hostColumnName).Limit(int(params.Limit))
+
+	return selectRows[ServerSummary](ctx, s.db, sb)
+}
+
+// CountServers returns count of servers of the multiplayer, servers missing from the latest snapshot are counted only with includeOffline.
+func (s *Store) CountServers(ctx context.Context, multiplayer domain.Multiplayer, includeOffline bool) (uint64, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	if includeOffline {
+		sb = sb.From(serversInfoTableName).
+			Select("count(*)").
+			Where(sb.Equal(multiplayerColumnName, string(multiplayer)))
+	} else {
+		sb = sb.From(sb.BuilderAs(latestOnlineBuilder(multiplayer), serversOnlineTableName)).
+			Select("count(*)")
+	}
+
+	sqlRaw, args := sql.BuildPostgreSQL(sb)
+
+	var count int64
+	if err := s.db.QueryRow(ctx, sqlRaw, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("s.db.QueryRow: %w", err)
+	}
+
+	return uint64(count), nil //nolint:gosec
+}
+
+// SearchServers returns page of servers found by params, ordered by current players count.
+func (s *Store) SearchServers(ctx context.Context, params domain.SearchServersParams) ([]ServerSummary, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(sb.BuilderAs(searchServersBuilder(params), "servers")).
+		Select(hostColumnName, nameColumnName, playersCountColumnName, onlineAlias, lastSeenAtAlias).
+		OrderByDesc(playersCountColumnName).
+		OrderByAsc(hostColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
+
+	return selectRows[ServerSummary](ctx, s.db, sb)
+}
+
+// ListServerFacets returns counts of servers found by params per language and gamemode.
+func (s *Store) ListServerFacets(ctx context.Context, params domain.SearchServersParams) ([]ServerFacetValue, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(
+		sb.BuilderAs(searchServersBuilder(params), "servers"),
+		fmt.Sprintf("LATERAL (VALUES ('%s', %s), ('%s', %s)) AS facets(%s, %s)",
+			FacetLanguage, languageColumnName, FacetGamemode, gamemodeColumnName, facetAlias, facetValueAlias,
+		),
+	).
+		Select(facetAlias, facetValueAlias, sb.As("count(*)", facetCountAlias)).
+		GroupBy(facetAlias, facetValueAlias).
+		OrderByDesc(facetCountAlias).
+		OrderByAsc(facetValueAlias)
+
+	return selectRows[ServerFacetValue](ctx, s.db, sb)
+}
+
+// ListTrendingServers compares average players of servers in the window ending now with the previous window.
+func (s *Store) ListTrendingServers(ctx context.Context, params domain.ListTrendingServersParams) ([]TrendingServer, error) {
+	var (
+		to    = time.Now()
+		split = to.Add(-params.Window.Duration())
+		from  = split.Add(-params.Window.Duration())
+	)
+
+	trends := sqlbuilder.NewSelectBuilder()
+
+	trends = trends.From(serversOnlineTableName).
+		Select(
+			hostColumnName,
+			trends.As(fmt.Sprintf("coalesce(avg(%s) FILTER (WHERE %s), 0)::float8", playersCountColumnName, trends.LessThan(collectedAtColumnName, split)), playersBeforeAlias),
+			trends.As(fmt.Sprintf("coalesce(avg(%s) FILTER (WHERE %s), 0)::float8", playersCountColumnName, trends.GreaterEqualThan(collectedAtColumnName, split)), playersAfterAlias),
+		).
+		Where(
+			trends.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			trends.GreaterEqualThan(collectedAtColumnName, from),
+			trends.LessThan(collectedAtColumnName, to),
+		).
+		GroupBy(hostColumnName)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	orderBy := playersChangeAlias
+	if params.OrderBy == domain.TrendingOrderRelative {
+		orderBy = relativeChangeAlias
+	}
+
+	sb = sb.From(sb.BuilderAs(trends, "trends")).
+		Select(
+			sb.As("trends."+hostColumnName, hostColumnName),
+			sb.As(fmt.Sprintf("coalesce(%s.%s, '')", serversInfoTableName, nameColumnName), nameColumnName),
+			playersBeforeAlias,
+			playersAfterAlias,
+			sb.As(fmt.Sprintf("%s - %s", playersAfterAlias, playersBeforeAlias), playersChangeAlias),
+			sb.As(fmt.Sprintf("(%s - %s) / nullif(%s, 0)", playersAfterAlias, playersBeforeAlias, playersBeforeAlias), relativeChangeAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			serversInfoTableName,
+			fmt.Sprintf("%s.%s = trends.%s", serversInfoTableName, hostColumnName, hostColumnName),
+			sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(params.Multiplayer)),
+		).
+		OrderBy(orderBy + " DESC NULLS LAST").
+		OrderByAsc(hostColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
+
+	return selectRows[TrendingServer](ctx, s.db, sb)
+}
+
+// GetServer ...
+func (s *Store) GetServer(ctx context.Context, multiplayer domain.Multiplayer, host string) (Server, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversInfoTableName).
+		Select(
+			serversInfoTableName+"."+multiplayerColumnName,
+			serversInfoTableName+"."+hostColumnName,
+			nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName,
+			sb.As(onlinePlayersColumn(), playersCountColumnName),
+			maxPlayersColumnName, peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName,
+			serversInfoTableName+"."+collectedAtColumnName,
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(latestOnlineBuilder(multiplayer), serversOnlineTableName),
+			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
+		).
+		Where(
+			sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(multiplayer)),
+			sb.Equal(serversInfoTableName+"."+hostColumnName, host),
+		)
+
+	sqlRaw, args := sql.BuildPostgreSQL(sb)
+
+	rows, err := s.db.Query(ctx, sqlRaw, args...)
+	if err != nil {
+		return Server{}, fmt.Errorf("s.db.Query: %w", err)
+	}
+
+	server, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[Server])
+	if err != nil {
+		return Server{}, fmt.Errorf("pgx.CollectExactlyOneRow: %w", err)
+	}
+
+	return server, nil
+}
+
+// ListServerStatistics reads raw online, or daily continuous aggregate for daily and longer precisions,
+// when TimescaleDB is enabled and percentiles are not requested.
+// When params.Fill is set, missing buckets of the time range are returned with status computed by collection runs.
+func (s *Store) ListServerStatistics(ctx context.Context, params domain.ListServerStatisticsParams) ([]ServerStatisticPoint, error) {
+	points := s.statisticPointsBuilder(params)
+
+	if !params.Fill {
+		sb := sqlbuilder.NewSelectBuilder()
+
+		sb = sb.From(sb.BuilderAs(points, "points")).
+			Select(
+				sb.As(fmt.Sprintf("'%s'", domain.ServerStatisticStatusOnline), statusColumnName),
+				"points.*",
+			).
+			OrderByDesc(collectedAtColumnName)
+
+		return selectRows[ServerStatisticPoint](ctx, s.db, sb)
+	}
+
+	runs := sqlbuilder.NewSelectBuilder()
+
+	runs = runs.From(collectionRunsTableName).
+		Select(
+			runs.As(bucketOf(params.Precision, collectedAtColumnName), collectedAtColumnName),
+			runs.As(fmt.Sprintf("bool_or(%s = '%s')", statusColumnName, domain.CollectionRunStatusOK), succeededAlias),
+		).
+		Where(
+			runs.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			runs.GreaterEqualThan(collectedAtColumnName, params.TimeRange.From),
+			runs.LessThan(collectedAtColumnName, params.TimeRange.To),
+		).
+		GroupBy("1")
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	series := fmt.Sprintf("generate_series(%s, %s::timestamptz, %s) AS series(%s)",
+		bucketOf(params.Precision, sb.Var(params.TimeRange.From)+"::timestamptz"),
+		sb.Var(params.TimeRange.To),
+		stepOf(params.Precision),
+		collectedAtColumnName,
+	)
+
+	sb = sb.From(series).
+		Select(
+			sb.As(fmt.Sprintf(
+				"CASE WHEN points.%s IS NOT NULL THEN '%s' WHEN runs.%s THEN '%s' WHEN runs.%s IS NOT NULL THEN '%s' ELSE '%s' END",
+				collectedAtColumnName, domain.ServerStatisticStatusOnline,
+				succeededAlias, domain.ServerStatisticStatusOffline,
+				succeededAlias, domain.ServerStatisticStatusCollectionFailed,
+				domain.ServerStatisticStatusUnknown,
+			), statusColumnName),
+			sb.As(coalesceZero("points."+playersCountColumnName), playersCountColumnName),
+			sb.As(coalesceZero("points."+minPlayersCountAlias), minPlayersCountAlias),
+			sb.As(coalesceZero("points."+maxPlayersCountAlias), maxPlayersCountAlias),
+			sb.As(coalesceZero("points."+p50PlayersCountAlias), p50PlayersCountAlias),
+			sb.As(coalesceZero("points."+p95PlayersCountAlias), p95PlayersCountAlias),
+			sb.As(coalesceZero("points."+samplesCountAlias), samplesCountAlias),
+			sb.As("series."+collectedAtColumnName, collectedAtColumnName),
+		).
+		JoinWithOption(sqlbuilder.LeftJoin, sb.BuilderAs(points, "points"), "points."+collectedAtColumnName+" = series."+collectedAtColumnName).
+		JoinWithOption(sqlbuilder.LeftJoin, sb.BuilderAs(runs, "runs"), "runs."+collectedAtColumnName+" = series."+collectedAtColumnName).
+		Where(sb.LessThan("series."+collectedAtColumnName, params.TimeRange.To)).
+		OrderByDesc(collectedAtColumnName)
+
+	return selectRows[ServerStatisticPoint](ctx, s.db, sb)
+}
+
+func (s *Store) statisticPointsBuilder(params domain.ListServerStatisticsParams) *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	percentilesRequested := lo.ContainsBy(params.Aggregates, func(aggregate domain.ServerStatisticAggregate) bool {
+		return aggregate == domain.ServerStatisticAggregateP50 || aggregate == domain.ServerStatisticAggregateP95
+	})
+
+	if s.timescaleDB && isDailyOrLonger(params.Precision) && !percentilesRequested {
+		// daily averages are weighted by their samples, to be merged into longer buckets.
+		return sb.From(serversOnlineDailyTableName).
+			Select(
+				sb.As(bucketOf(params.Precision, collectedAtColumnName), collectedAtColumnName),
+				sb.As(fmt.Sprintf("trunc(sum(%s * %s) / sum(%s))::int", playersAvgColumnName, samplesColumnName, samplesColumnName), playersCountColumnName),
+				sb.As(wrapColumn("min", playersMinColumnName), minPlayersCountAlias),
+				sb.As(wrapColumn("max", playersMaxColumnName), maxPlayersCountAlias),
+				sb.As("0", p50PlayersCountAlias),
+				sb.As("0", p95PlayersCountAlias),
+				sb.As(fmt.Sprintf("sum(%s)::bigint", samplesColumnName), samplesCountAlias),
+			).
+			Where(
+				sb.Equal(multiplayerColumnName, string(params.Multiplayer)),
+				sb.Equal(hostColumnName, params.Host),
+				fmt.Sprintf("%s >= %s", collectedAtColumnName, bucketOf(params.Precision, sb.Var(params.TimeRange.From)+"::timestamptz")),
+				sb.LessThan(collectedAtColumnName, params.TimeRange.To),
+			).
+			GroupBy("1")
+	}
+
+	return sb.From(serversOnlineTableName).
+		Select(
+			sb.As(bucketOf(params.Precision, collectedAtColumnName), collectedAtColumnName),
+			sb.As(fmt.Sprintf("trunc(avg(%s))::int", playersCountColumnName), playersCountColumnName),
+			sb.As(wrapColumn("min", playersCountColumnName), minPlayersCountAlias),
+			sb.As(wrapColumn("max", playersCountColumnName), maxPlayersCountAlias),
+			sb.As(percentileOf(0.5), p50PlayersCountAlias),
+			sb.As(percentileOf(0.95), p95PlayersCountAlias),
+			sb.As("count(*)", samplesCountAlias),
+		).
+		Where(
+			sb.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			sb.Equal(hostColumnName, params.Host),
+			sb.GreaterThan(collectedAtColumnName, params.TimeRange.From),
+			sb.LessThan(collectedAtColumnName, params.TimeRange.To),
+		).
+		GroupBy("1")
+}
+
+// ListServerMetadata returns the last known metadata of all servers of the multiplayer.
+func (s *Store) ListServerMetadata(ctx context.Context, multiplayer domain.Multiplayer) ([]Server, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversInfoTableName).
+		Select(
+			multiplayerColumnName, hostColumnName, nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName,
+			sb.As("0", playersCountColumnName), maxPlayersColumnName, peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName, collectedAtColumnName,
+		).
+		Where(sb.Equal(multiplayerColumnName, string(multiplayer)))
+
+	return selectRows[Server](ctx, s.db, sb)
+}
+
+// InsertServerChanges ...
+func (s *Store) InsertServerChanges(ctx context.Context, changes []ServerChange) error {
+	for _, chunk := range lo.Chunk(changes, insertChunkSize) {
+		ib := sqlbuilder.NewInsertBuilder().
+			InsertInto(serversHistoryTableName).
+			Cols(multiplayerColumnName, hostColumnName, fieldColumnName, oldValueColumnName, newValueColumnName, changedAtColumnName)
+
+		for _, change := range chunk {
+			ib.Values(change.Multiplayer, change.Host, change.Field, change.OldValue, change.NewValue, change.ChangedAt)
+		}
+
+		ib.SQL("ON CONFLICT DO NOTHING")
+
+		sqlRaw, args := sql.BuildPostgreSQL(ib)
+
+		if _, err := s.db.Exec(ctx, sqlRaw, args...); err != nil {
+			return fmt.Errorf("s.db.Exec: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ListServerChanges ...
+func (s *Store) ListServerChanges(ctx context.Context, params domain.ListServerChangesParams) ([]ServerChange, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversHistoryTableName).
+		Select(multiplayerColumnName, hostColumnName, fieldColumnName, oldValueColumnName, newValueColumnName, changedAtColumnName).
+		Where(
+			sb.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			sb.Equal(hostColumnName, params.Host),
+		).
+		OrderByDesc(changedAtColumnName).
+		OrderByAsc(fieldColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
+
+	return selectRows[ServerChange](ctx, s.db, sb)
+}
+
+// GetServerSeen returns the first and the last time, that server was present in snapshots.
+func (s *Store) GetServerSeen(ctx context.Context, multiplayer domain.Multiplayer, host string) (ServerSeen, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	// rows are grouped, so unknown server has no rows instead of a row of nulls.
+	sb = sb.From(serversOnlineTableName).
+		Select(
+			sb.As(wrapColumn("min", collectedAtColumnName), firstSeenAtAlias),
+			sb.As(wrapColumn("max", collectedAtColumnName), lastSeenAtAlias),
+		).
+		Where(
+			sb.Equal(multiplayerColumnName, string(multiplayer)),
+			sb.Equal(hostColumnName, host),
+		).
+		GroupBy(multiplayerColumnName, hostColumnName)
+
+	sqlRaw, args := sql.BuildPostgreSQL(sb)
+
+	rows, err := s.db.Query(ctx, sqlRaw, args...)
+	if err != nil {
+		return ServerSeen{}, fmt.Errorf("s.db.Query: %w", err)
+	}
+
+	seen, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[ServerSeen])
+	if err != nil {
+		return ServerSeen{}, fmt.Errorf("pgx.CollectExactlyOneRow: %w", err)
+	}
+
+	return seen, nil
+}
+
+// ListServerRunPresence returns successful collection runs of the multiplayer since from ordered by time,
+// with presence of the server in them.
+func (s *Store) ListServerRunPresence(ctx context.Context, multiplayer domain.Multiplayer, host string, from time.Time) ([]ServerRunPresence, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(collectionRunsTableName).
+		Select(
+			sb.As(collectionRunsTableName+"."+collectedAtColumnName, collectedAtColumnName),
+			sb.As(fmt.Sprintf("%s.%s IS NOT NULL", serversOnlineTableName, hostColumnName), presentAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			serversOnlineTableName,
+			sb.And(
+				fmt.Sprintf("%s.%s = %s.%s", serversOnlineTableName, multiplayerColumnName, collectionRunsTableName, multiplayerColumnName),
+				fmt.Sprintf("%s.%s = %s.%s", serversOnlineTableName, collectedAtColumnName, collectionRunsTableName, collectedAtColumnName),
+				sb.Equal(serversOnlineTableName+"."+hostColumnName, host),
+			),
+		).
+		Where(
+			sb.Equal(collectionRunsTableName+"."+multiplayerColumnName, string(multiplayer)),
+			sb.Equal(collectionRunsTableName+"."+statusColumnName, string(domain.CollectionRunStatusOK)),
+			sb.GreaterEqualThan(collectionRunsTableName+"."+collectedAtColumnName, from),
+		).
+		OrderByAsc(collectedAtColumnName)
+
+	return selectRows[ServerRunPresence](ctx, s.db, sb)
+}
+
+// InsertCollectionRun ...
+func (s *Store) InsertCollectionRun(ctx context.Context, run CollectionRun) error {
+	ib := sqlbuilder.NewInsertBuilder()
+
+	ib = ib.InsertInto(collectionRunsTableName).
+		Cols(multiplayerColumnName, collectedAtColumnName, statusColumnName, serversCountColumnName, errorColumnName).
+		Values(run.Multiplayer, run.CollectedAt, run.Status, run.ServersCount, run.Error).
+		SQL(fmt.Sprintf("ON CONFLICT (%s, %s, %s) DO UPDATE SET %s = excluded.%s, %s = excluded.%s",
+			multiplayerColumnName, collectedAtColumnName, statusColumnName,
+			serversCountColumnName, serversCountColumnName, errorColumnName, errorColumnName,
+		))
+
+	sqlRaw, args := sql.BuildPostgreSQL(ib)
+
+	if _, err := s.db.Exec(ctx, sqlRaw, args...); err != nil {
+		return fmt.Errorf("s.db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func selectRows[T any](ctx context.Context, db conn, builder sql.Builder) ([]T, error) {
+	sqlRaw, args := sql.BuildPostgreSQL(builder)
+
+	rows, err := db.Query(ctx, sqlRaw, args...)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+
+	result, err := pgx.CollectRows(rows, pgx.RowToStructByName[T])
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	return result, nil
+}
+
+// serverSummariesBuilder returns query of servers of the multiplayer with their current players count.
+// Servers missing from the latest snapshot are returned only with includeOffline.
+func serverSummariesBuilder(multiplayer domain.Multiplayer, includeOffline bool) *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversInfoTableName).
+		Select(
+			sb.As(serversInfoTableName+"."+hostColumnName, hostColumnName),
+			nameColumnName,
+			sb.As(onlinePlayersColumn(), playersCountColumnName),
+			sb.As(onlineColumn(), onlineAlias),
+			sb.As(serversInfoTableName+"."+collectedAtColumnName, lastSeenAtAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(latestOnlineBuilder(multiplayer), serversOnlineTableName),
+			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
+		).
+		Where(sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(multiplayer)))
+
+	if !includeOffline {
+		sb = sb.Where(onlineColumn())
+	}
+
+	return sb
+}
+
+// searchServersBuilder returns query of servers found by params with their current players count.
+func searchServersBuilder(params domain.SearchServersParams) *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	var (
+		playersColumn = onlinePlayersColumn()
+	)
+
+	conds := []string{sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(params.Multiplayer))}
+
+	if params.Query != "" {
+		conds = append(conds, fmt.Sprintf("%s.%s ILIKE %s", serversInfoTableName, nameColumnName, sb.Var("%"+escapeLike(params.Query)+"%")))
+	}
+
+	if params.Language != "" {
+		conds = append(conds, sb.Equal(languageColumnName, params.Language))
+	}
+
+	if params.Gamemode != "" {
+		conds = append(conds, sb.Equal(gamemodeColumnName, params.Gamemode))
+	}
+
+	if params.MinPlayers != nil {
+		conds = append(conds, sb.GreaterEqualThan(playersColumn, *params.MinPlayers))
+	}
+
+	if params.MaxPlayers != nil {
+		conds = append(conds, sb.LessEqualThan(playersColumn, *params.MaxPlayers))
+	}
+
+	switch params.Online {
+	case domain.ServerOnlineFilterOnline:
+		conds = append(conds, onlineColumn())
+	case domain.ServerOnlineFilterOffline:
+		conds = append(conds, "NOT "+onlineColumn())
+	case domain.ServerOnlineFilterAny:
+	}
+
+	return sb.From(serversInfoTableName).
+		Select(
+			sb.As(serversInfoTableName+"."+hostColumnName, hostColumnName),
+			nameColumnName,
+			languageColumnName,
+			gamemodeColumnName,
+			sb.As(playersColumn, playersCountColumnName),
+			sb.As(onlineColumn(), onlineAlias),
+			sb.As(serversInfoTableName+"."+collectedAtColumnName, lastSeenAtAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(latestOnlineBuilder(params.Multiplayer), serversOnlineTableName),
+			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
+		).
+		Where(conds...)
+}
+
+// latestSnapshotsBuilder returns query of the latest snapshot time of every multiplayer.
+func latestSnapshotsBuilder() *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	return sb.From(serversOnlineTableName).
+		Select(multiplayerColumnName, wrapColumn("max", collectedAtColumnName)).
+		Where(latestSnapshotAgeCond()).
+		GroupBy(multiplayerColumnName)
+}
+
+// latestOnlineBuilder returns query of servers online of the multiplayer in its latest snapshot.
+func latestOnlineBuilder(multiplayer domain.Multiplayer) *sqlbuilder.SelectBuilder {
+	snapshot := sqlbuilder.NewSelectBuilder()
+	snapshot = snapshot.From(serversOnlineTableName).
+		Select(wrapColumn("max", collectedAtColumnName)).
+		Where(
+			snapshot.Equal(multiplayerColumnName, string(multiplayer)),
+			latestSnapshotAgeCond(),
+		)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	return sb.From(serversOnlineTableName).
+		Select(hostColumnName, playersCountColumnName).
+		Where(
+			sb.Equal(multiplayerColumnName, string(multiplayer)),
+			fmt.Sprintf("%s = (%s)", collectedAtColumnName, sb.Var(snapshot)),
+		)
+}
+
+func latestSnapshotAgeCond() string {
+	return fmt.Sprintf("%s >= now() - INTERVAL '%d seconds'", collectedAtColumnName, int(latestSnapshotMaxAge.Seconds()))
+}
+
+// onlineColumn returns condition, whether server of servers_info is present in the joined latest snapshot.
+func onlineColumn() string {
+	return fmt.Sprintf("%s.%s IS NOT NULL", serversOnlineTableName, hostColumnName)
+}
+
+// onlinePlayersColumn returns players count of the latest snapshot, that is zero for offline servers.
+func onlinePlayersColumn() string {
+	return coalesceZero(serversOnlineTableName + "." + playersCountColumnName)
+}
+
+// bucketOf returns expression of the bucket start of timestamptz expression in UTC.
+func bucketOf(precision domain.ServerStatisticsPrecision, expr string) string {
+	switch precision {
+	case domain.ServerStatisticsPrecisionPerFiveMinutes:
+		return fmt.Sprintf("date_bin(INTERVAL '5 minutes', %s, TIMESTAMPTZ '2000-01-01 00:00:00+00')", expr)
+	case domain.ServerStatisticsPrecisionPerFifteenMinutes:
+		return fmt.Sprintf("date_bin(INTERVAL '15 minutes', %s, TIMESTAMPTZ '2000-01-01 00:00:00+00')", expr)
+	case domain.ServerStatisticsPrecisionPerDay:
+		return fmt.Sprintf("date_trunc('day', %s, 'UTC')", expr)
+	case domain.ServerStatisticsPrecisionPerWeek:
+		return fmt.Sprintf("date_trunc('week', %s, 'UTC')", expr)
+	case domain.ServerStatisticsPrecisionPerMonth:
+		return fmt.Sprintf("date_trunc('month', %s, 'UTC')", expr)
+	default:
+		return fmt.Sprintf("date_trunc('hour', %s, 'UTC')", expr)
+	}
+}
+
+// isDailyOrLonger reports, whether buckets of the precision consist of whole days.
+func isDailyOrLonger(precision domain.ServerStatisticsPrecision) bool {
+	switch precision {
+	case domain.ServerStatisticsPrecisionPerDay, domain.ServerStatisticsPrecisionPerWeek, domain.ServerStatisticsPrecisionPerMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+// stepOf returns interval between buckets of the precision.
+func stepOf(precision domain.ServerStatisticsPrecision) string {
+	switch precision {
+	case domain.ServerStatisticsPrecisionPerFiveMinutes:
+		return "INTERVAL '5 minutes'"
+	case domain.ServerStatisticsPrecisionPerFifteenMinutes:
+		return "INTERVAL '15 minutes'"
+	case domain.ServerStatisticsPrecisionPerDay:
+		return "INTERVAL '1 day'"
+	case domain.ServerStatisticsPrecisionPerWeek:
+		return "INTERVAL '1 week'"
+	case domain.ServerStatisticsPrecisionPerMonth:
+		return "INTERVAL '1 month'"
+	default:
+		return "INTERVAL '1 hour'"
+	}
+}
+
+func percentileOf(fraction float64) string {
+	return fmt.Sprintf("percentile_disc(%g) WITHIN GROUP (ORDER BY %s)", fraction, playersCountColumnName)
+}
+
+func coalesceZero(expr string) string {
+	return fmt.Sprintf("coalesce(%s, 0)", expr)
+}
+
+// escapeLike escapes special characters of LIKE pattern.
+func escapeLike(value string) string {
+	return likeReplacer.Replace(value)
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func wrapColumn(wrapper, columnName string) string {
+	return wrapper + "(" + columnName + ")"
+}
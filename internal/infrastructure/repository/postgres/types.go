@@ -0,0 +1,166 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package postgres
+
+import "time"
+
+const (
+	serversInfoTableName        = "servers_info"
+	serversOnlineTableName      = "servers_online"
+	serversHistoryTableName     = "servers_history"
+	collectionRunsTableName     = "collection_runs"
+	serversOnlineDailyTableName = "servers_online_daily"
+
+	multiplayerColumnName  = "multiplayer"
+	hostColumnName         = "host"
+	nameColumnName         = "name"
+	languageColumnName     = "language"
+	gamemodeColumnName     = "gamemode"
+	urlColumnName          = "url"
+	playersCountColumnName = "players_count"
+	maxPlayersColumnName   = "max_players"
+	peakPlayersColumnName  = "peak_players"
+	mapColumnName          = "map"
+	versionColumnName      = "version"
+	passwordedColumnName   = "passworded"
+	tagsColumnName         = "tags"
+	collectedAtColumnName  = "collected_at"
+	fieldColumnName        = "field"
+	oldValueColumnName     = "old_value"
+	newValueColumnName     = "new_value"
+	changedAtColumnName    = "changed_at"
+	statusColumnName       = "status"
+	serversCountColumnName = "servers_count"
+	errorColumnName        = "error"
+	playersAvgColumnName   = "players_avg"
+	playersMinColumnName   = "players_min"
+	playersMaxColumnName   = "players_max"
+	samplesColumnName      = "samples"
+
+	minPlayersCountAlias = "min_players_count"
+	maxPlayersCountAlias = "max_players_count"
+	p50PlayersCountAlias = "p50_players_count"
+	p95PlayersCountAlias = "p95_players_count"
+	samplesCountAlias    = "samples_count"
+	succeededAlias       = "succeeded"
+	playersBeforeAlias   = "players_before"
+	playersAfterAlias    = "players_after"
+	playersChangeAlias   = "players_change"
+	relativeChangeAlias  = "relative_change"
+	facetAlias           = "facet"
+	facetValueAlias      = "value"
+	facetCountAlias      = "count"
+	onlineAlias          = "online"
+	lastSeenAtAlias      = "last_seen_at"
+	firstSeenAtAlias     = "first_seen_at"
+	presentAlias         = "present"
+)
+
+// Server ...
+type Server struct {
+	Multiplayer  string    `db:"multiplayer"`
+	Host         string    `db:"host"`
+	Name         string    `db:"name"`
+	URL          string    `db:"url"`
+	Gamemode     string    `db:"gamemode"`
+	Language     string    `db:"language"`
+	PlayersCount int32     `db:"players_count"`
+	MaxPlayers   int32     `db:"max_players"`
+	PeakPlayers  int32     `db:"peak_players"`
+	Map          string    `db:"map"`
+	Version      string    `db:"version"`
+	Passworded   *bool     `db:"passworded"`
+	Tags         []string  `db:"tags"`
+	CollectedAt  time.Time `db:"collected_at"`
+}
+
+// MultiplayerSummary ...
+type MultiplayerSummary struct {
+	Multiplayer  string `db:"multiplayer"`
+	PlayersCount int64  `db:"players_count"`
+}
+
+// MultiplayerStatisticPoint ...
+type MultiplayerStatisticPoint struct {
+	PlayersCount int64     `db:"players_count"`
+	ServersCount int64     `db:"servers_count"`
+	CollectedAt  time.Time `db:"collected_at"`
+}
+
+// ServerSummary ...
+// LastSeenAt is a time of the latest snapshot, that server was present in.
+type ServerSummary struct {
+	Host         string    `db:"host"`
+	Name         string    `db:"name"`
+	PlayersCount int32     `db:"players_count"`
+	Online       bool      `db:"online"`
+	LastSeenAt   time.Time `db:"last_seen_at"`
+}
+
+// Facets of ServerFacetValue.
+const (
+	FacetLanguage = "language"
+	FacetGamemode = "gamemode"
+)
+
+// ServerFacetValue ...
+type ServerFacetValue struct {
+	Facet string `db:"facet"`
+	Value string `db:"value"`
+	Count int64  `db:"count"`
+}
+
+// TrendingServer ...
+type TrendingServer struct {
+	Host           string   `db:"host"`
+	Name           string   `db:"name"`
+	PlayersBefore  float64  `db:"players_before"`
+	PlayersAfter   float64  `db:"players_after"`
+	PlayersChange  float64  `db:"players_change"`
+	RelativeChange *float64 `db:"relative_change"`
+}
+
+// ServerStatisticPoint ...
+// Status is one of domain.ServerStatisticStatus values, it is computed by collection runs for missing buckets.
+type ServerStatisticPoint struct {
+	Status          string    `db:"status"`
+	PlayersCount    int32     `db:"players_count"`
+	MinPlayersCount int32     `db:"min_players_count"`
+	MaxPlayersCount int32     `db:"max_players_count"`
+	P50PlayersCount int32     `db:"p50_players_count"`
+	P95PlayersCount int32     `db:"p95_players_count"`
+	SamplesCount    int64     `db:"samples_count"`
+	CollectedAt     time.Time `db:"collected_at"`
+}
+
+// ServerChange ...
+type ServerChange struct {
+	Multiplayer string    `db:"multiplayer"`
+	Host        string    `db:"host"`
+	Field       string    `db:"field"`
+	OldValue    string    `db:"old_value"`
+	NewValue    string    `db:"new_value"`
+	ChangedAt   time.Time `db:"changed_at"`
+}
+
+// CollectionRun ...
+type CollectionRun struct {
+	Multiplayer  string
+	CollectedAt  time.Time
+	Status       string
+	ServersCount int32
+	Error        string
+}
+
+// ServerSeen ...
+type ServerSeen struct {
+	FirstSeenAt time.Time `db:"first_seen_at"`
+	LastSeenAt  time.Time `db:"last_seen_at"`
+}
+
+// ServerRunPresence ...
+type ServerRunPresence struct {
+	CollectedAt time.Time `db:"collected_at"`
+	Present     bool      `db:"present"`
+}
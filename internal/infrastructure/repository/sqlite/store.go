@@ -0,0 +1,810 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package sqlite
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/samber/lo"
+
+	"github.com/EpicStep/gdatum/internal/domain"
+	"github.com/EpicStep/gdatum/internal/utils/sql"
+)
+
+const (
+	// latestSnapshotMaxAge is a maximum age of the snapshot, that is treated as the latest one.
+	// Multiplayers that were not collected for longer are treated as offline.
+	latestSnapshotMaxAge = 24 * time.Hour
+
+	// insertChunkSize keeps inserts below the limit of bind parameters of a single statement.
+	insertChunkSize = 1000
+
+	// mondayUnix is the first Monday since unix epoch, weeks are aligned to it.
+	mondayUnix = 4 * 24 * 60 * 60
+)
+
+var errUnknownColumn = errors.New("column is not mapped to struct field")
+
+// Store ...
+type Store struct {
+	db *dbsql.DB
+}
+
+// New ...
+func New(db *dbsql.DB) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// InsertServers inserts servers of the snapshot, repeated insert of the same snapshot is ignored by primary key.
+func (s *Store) InsertServers(ctx context.Context, servers []Server) error {
+	if len(servers) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("s.db.BeginTx: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	for _, chunk := range lo.Chunk(servers, insertChunkSize) {
+		online := sqlbuilder.NewInsertBuilder().
+			InsertInto(serversOnlineTableName).
+			Cols(multiplayerColumnName, hostColumnName, playersCountColumnName, collectedAtColumnName)
+
+		info := sqlbuilder.NewInsertBuilder().
+			InsertInto(serversInfoTableName).
+			Cols(
+				multiplayerColumnName, hostColumnName, nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName,
+				maxPlayersColumnName, peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName, collectedAtColumnName,
+			)
+
+		for _, server := range chunk {
+			online.Values(server.Multiplayer, server.Host, server.PlayersCount, server.CollectedAt)
+			info.Values(
+				server.Multiplayer, server.Host, server.Name, server.URL, server.Gamemode, server.Language,
+				server.MaxPlayers, server.PeakPlayers, server.Map, server.Version, server.Passworded, server.Tags, server.CollectedAt,
+			)
+		}
+
+		online.SQL("ON CONFLICT DO NOTHING")
+
+		// info of older snapshot, e.g. inserted by retry, does not override newer one.
+		// the last value of the same server in the statement wins, as every row is upserted in order.
+		info.SQL(fmt.Sprintf("ON CONFLICT (%s, %s) DO UPDATE SET %s WHERE %s.%s <= excluded.%s",
+			multiplayerColumnName, hostColumnName,
+			strings.Join(lo.Map([]string{
+				nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName, maxPlayersColumnName,
+				peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName, collectedAtColumnName,
+			}, func(column string, _ int) string {
+				return column + " = excluded." + column
+			}), ", "),
+			serversInfoTableName, collectedAtColumnName, collectedAtColumnName,
+		))
+
+		for _, ib := range []*sqlbuilder.InsertBuilder{online, info} {
+			sqlRaw, args := sql.BuildSQLite(ib)
+
+			if _, err = tx.ExecContext(ctx, sqlRaw, args...); err != nil {
+				return fmt.Errorf("tx.ExecContext: %w", err)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return nil
+}
+
+// ListMultiplayerSummaries ...
+func (s *Store) ListMultiplayerSummaries(ctx context.Context, playersOrderAsc bool) ([]MultiplayerSummary, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversOnlineTableName).
+		Select(multiplayerColumnName, sb.As(wrapColumn("sum", playersCountColumnName), playersCountColumnName)).
+		Where(fmt.Sprintf("(%s, %s) IN (%s)", multiplayerColumnName, collectedAtColumnName, sb.Var(s.latestSnapshotsBuilder()))).
+		GroupBy(multiplayerColumnName)
+
+	if playersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
+		sb = sb.OrderByDesc(playersCountColumnName)
+	}
+
+	return selectRows[MultiplayerSummary](ctx, s.db, sb)
+}
+
+// ListMultiplayerStatistics reads multiplayer totals, that are summed per snapshot and averaged in the bucket.
+func (s *Store) ListMultiplayerStatistics(ctx context.Context, params domain.ListMultiplayerStatisticsParams) ([]MultiplayerStatisticPoint, error) {
+	snapshots := sqlbuilder.NewSelectBuilder()
+
+	snapshots = snapshots.From(serversOnlineTableName).
+		Select(
+			collectedAtColumnName,
+			snapshots.As(wrapColumn("sum", playersCountColumnName), playersCountColumnName),
+			snapshots.As("count(*)", serversCountColumnName),
+		).
+		Where(
+			snapshots.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			snapshots.GreaterThan(collectedAtColumnName, params.TimeRange.From.Unix()),
+			snapshots.LessThan(collectedAtColumnName, params.TimeRange.To.Unix()),
+		).
+		GroupBy(collectedAtColumnName)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(sb.BuilderAs(snapshots, "snapshots")).
+		Select(
+			sb.As(bucketOf(params.Precision, collectedAtColumnName), collectedAtColumnName),
+			sb.As(castInteger(wrapColumn("avg", playersCountColumnName)), playersCountColumnName),
+			sb.As(castInteger(wrapColumn("avg", serversCountColumnName)), serversCountColumnName),
+		).
+		GroupBy("1").
+		OrderByDesc(collectedAtColumnName)
+
+	return selectRows[MultiplayerStatisticPoint](ctx, s.db, sb)
+}
+
+// ListServerSummaries returns servers of the latest snapshot, servers missing from it are returned only with params.IncludeOffline.
+func (s *Store) ListServerSummaries(ctx context.Context, params domain.ListServerSummariesParams) ([]ServerSummary, error) {
+	sb := s.serverSummariesBuilder(params.Multiplayer, params.IncludeOffline)
+
+	sb = sb.OrderByDesc(lastSeenAtAlias)
+
+	if params.PlayersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
+		sb = sb.OrderByDesc(playersCountColumnName)
+	}
+
+	sb = sb.Limit(int(params.Limit)).Offset(int(params.Offset))
+
+	return selectRows[ServerSummary](ctx, s.db, sb)
+}
+
+// ListServerSummariesPage returns servers ordered by players count and host, that are after params.After.
+func (s *Store) ListServerSummariesPage(ctx context.Context, params domain.ListServerSummariesPageParams) ([]ServerSummary, error) {
+	sb := s.serverSummariesBuilder(params.Multiplayer, params.IncludeOffline)
+
+	var (
+		hostColumn    = serversInfoTableName + "." + hostColumnName
+		playersColumn = onlinePlayersColumn()
+	)
+
+	if params.After != nil {
+		playersAfter := sb.LessThan(playersColumn, params.After.PlayersCount)
+		if params.PlayersOrderAsc {
+			playersAfter = sb.GreaterThan(playersColumn, params.After.PlayersCount)
+		}
+
+		sb = sb.Where(sb.Or(
+			playersAfter,
+			sb.And(sb.Equal(playersColumn, params.After.PlayersCount), sb.GreaterThan(hostColumn, params.After.Host)),
+		))
+	}
+
+	if params.PlayersOrderAsc {
+		sb = sb.OrderByAsc(playersCountColumnName)
+	} else {
+		sb = sb.OrderByDesc(playersCountColumnName)
+	}
+
+	sb = sb.OrderByAsc(hostColumnName).Limit(int(params.Limit))
+
+	return selectRows[ServerSummary](ctx, s.db, sb)
+}
+
+// CountServers returns count of servers of the multiplayer, servers missing from the latest snapshot are counted only with includeOffline.
+func (s *Store) CountServers(ctx context.Context, multiplayer domain.Multiplayer, includeOffline bool) (uint64, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	if includeOffline {
+		sb = sb.From(serversInfoTableName).
+			Select("count(*)").
+			Where(sb.Equal(multiplayerColumnName, string(multiplayer)))
+	} else {
+		sb = sb.From(sb.BuilderAs(s.latestOnlineBuilder(multiplayer), serversOnlineTableName)).
+			Select("count(*)")
+	}
+
+	sqlRaw, args := sql.BuildSQLite(sb)
+
+	var count uint64
+	if err := s.db.QueryRowContext(ctx, sqlRaw, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("s.db.QueryRowContext: %w", err)
+	}
+
+	return count, nil
+}
+
+// SearchServers returns page of servers found by params, ordered by current players count.
+// Name matching is case-insensitive only for ASCII letters, as LIKE of SQLite.
+func (s *Store) SearchServers(ctx context.Context, params domain.SearchServersParams) ([]ServerSummary, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(sb.BuilderAs(s.searchServersBuilder(params), "servers")).
+		Select(hostColumnName, nameColumnName, playersCountColumnName, onlineAlias, lastSeenAtAlias).
+		OrderByDesc(playersCountColumnName).
+		OrderByAsc(hostColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
+
+	return selectRows[ServerSummary](ctx, s.db, sb)
+}
+
+// ListServerFacets returns counts of servers found by params per language and gamemode.
+func (s *Store) ListServerFacets(ctx context.Context, params domain.SearchServersParams) ([]ServerFacetValue, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(
+		sb.BuilderAs(s.searchServersBuilder(params), "servers"),
+		fmt.Sprintf("(VALUES ('%s'), ('%s')) AS facets", FacetLanguage, FacetGamemode),
+	).
+		Select(
+			sb.As("facets.column1", facetAlias),
+			sb.As(fmt.Sprintf("CASE facets.column1 WHEN '%s' THEN %s ELSE %s END", FacetLanguage, languageColumnName, gamemodeColumnName), facetValueAlias),
+			sb.As("count(*)", facetCountAlias),
+		).
+		GroupBy("1", "2").
+		OrderByDesc(facetCountAlias).
+		OrderByAsc(facetValueAlias)
+
+	return selectRows[ServerFacetValue](ctx, s.db, sb)
+}
+
+// ListTrendingServers compares average players of servers in the window ending now with the previous window.
+func (s *Store) ListTrendingServers(ctx context.Context, params domain.ListTrendingServersParams) ([]TrendingServer, error) {
+	var (
+		to    = time.Now()
+		split = to.Add(-params.Window.Duration())
+		from  = split.Add(-params.Window.Duration())
+	)
+
+	trends := sqlbuilder.NewSelectBuilder()
+
+	trends = trends.From(serversOnlineTableName).
+		Select(
+			hostColumnName,
+			trends.As(fmt.Sprintf("coalesce(avg(%s) FILTER (WHERE %s), 0.0)", playersCountColumnName, trends.LessThan(collectedAtColumnName, split.Unix())), playersBeforeAlias),
+			trends.As(fmt.Sprintf("coalesce(avg(%s) FILTER (WHERE %s), 0.0)", playersCountColumnName, trends.GreaterEqualThan(collectedAtColumnName, split.Unix())), playersAfterAlias),
+		).
+		Where(
+			trends.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			trends.GreaterEqualThan(collectedAtColumnName, from.Unix()),
+			trends.LessThan(collectedAtColumnName, to.Unix()),
+		).
+		GroupBy(hostColumnName)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	orderBy := playersChangeAlias
+	if params.OrderBy == domain.TrendingOrderRelative {
+		orderBy = relativeChangeAlias
+	}
+
+	sb = sb.From(sb.BuilderAs(trends, "trends")).
+		Select(
+			sb.As("trends."+hostColumnName, hostColumnName),
+			sb.As(fmt.Sprintf("coalesce(%s.%s, '')", serversInfoTableName, nameColumnName), nameColumnName),
+			playersBeforeAlias,
+			playersAfterAlias,
+			sb.As(fmt.Sprintf("%s - %s", playersAfterAlias, playersBeforeAlias), playersChangeAlias),
+			sb.As(fmt.Sprintf("(%s - %s) / nullif(%s, 0)", playersAfterAlias, playersBeforeAlias, playersBeforeAlias), relativeChangeAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			serversInfoTableName,
+			fmt.Sprintf("%s.%s = trends.%s", serversInfoTableName, hostColumnName, hostColumnName),
+			sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(params.Multiplayer)),
+		).
+		OrderBy(orderBy + " DESC NULLS LAST").
+		OrderByAsc(hostColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
+
+	return selectRows[TrendingServer](ctx, s.db, sb)
+}
+
+// GetServer ...
+func (s *Store) GetServer(ctx context.Context, multiplayer domain.Multiplayer, host string) (Server, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversInfoTableName).
+		Select(
+			serversInfoTableName+"."+multiplayerColumnName,
+			sb.As(serversInfoTableName+"."+hostColumnName, hostColumnName),
+			nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName,
+			sb.As(onlinePlayersColumn(), playersCountColumnName),
+			maxPlayersColumnName, peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName,
+			sb.As(serversInfoTableName+"."+collectedAtColumnName, collectedAtColumnName),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(s.latestOnlineBuilder(multiplayer), serversOnlineTableName),
+			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
+		).
+		Where(
+			sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(multiplayer)),
+			sb.Equal(serversInfoTableName+"."+hostColumnName, host),
+		)
+
+	servers, err := selectRows[Server](ctx, s.db, sb)
+	if err != nil {
+		return Server{}, err
+	}
+
+	if len(servers) == 0 {
+		return Server{}, dbsql.ErrNoRows
+	}
+
+	return servers[0], nil
+}
+
+// ListServerStatistics ...
+// When params.Fill is set, missing buckets of the time range are returned with status computed by collection runs.
+func (s *Store) ListServerStatistics(ctx context.Context, params domain.ListServerStatisticsParams) ([]ServerStatisticPoint, error) {
+	bucket := bucketOf(params.Precision, collectedAtColumnName)
+
+	// percentiles are picked by row number in the bucket, as SQLite has no percentile functions.
+	samples := sqlbuilder.NewSelectBuilder()
+
+	samples = samples.From(serversOnlineTableName).
+		Select(
+			samples.As(bucket, bucketAlias),
+			playersCountColumnName,
+			samples.As(fmt.Sprintf("row_number() OVER (PARTITION BY %s ORDER BY %s)", bucket, playersCountColumnName), rowNumberAlias),
+			samples.As(fmt.Sprintf("count(*) OVER (PARTITION BY %s)", bucket), bucketSizeAlias),
+		).
+		Where(
+			samples.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			samples.Equal(hostColumnName, params.Host),
+			samples.GreaterThan(collectedAtColumnName, params.TimeRange.From.Unix()),
+			samples.LessThan(collectedAtColumnName, params.TimeRange.To.Unix()),
+		)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(sb.BuilderAs(samples, "samples")).
+		Select(
+			sb.As(bucketAlias, collectedAtColumnName),
+			sb.As(castInteger(wrapColumn("avg", playersCountColumnName)), playersCountColumnName),
+			sb.As(wrapColumn("min", playersCountColumnName), minPlayersCountAlias),
+			sb.As(wrapColumn("max", playersCountColumnName), maxPlayersCountAlias),
+			sb.As(percentileOf(50), p50PlayersCountAlias),
+			sb.As(percentileOf(95), p95PlayersCountAlias),
+			sb.As("count(*)", samplesCountAlias),
+		).
+		GroupBy(bucketAlias).
+		OrderByDesc(collectedAtColumnName)
+
+	points, err := selectRows[ServerStatisticPoint](ctx, s.db, sb)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range points {
+		points[i].Status = string(domain.ServerStatisticStatusOnline)
+	}
+
+	if !params.Fill || len(points) == 0 {
+		return points, nil
+	}
+
+	runs, err := s.listCollectionRunBuckets(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillPoints(points, runs, params), nil
+}
+
+// listCollectionRunBuckets returns whether any collection run of the multiplayer succeeded per bucket.
+func (s *Store) listCollectionRunBuckets(ctx context.Context, params domain.ListServerStatisticsParams) (map[int64]bool, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(collectionRunsTableName).
+		Select(
+			sb.As(bucketOf(params.Precision, collectedAtColumnName), collectedAtColumnName),
+			sb.As(fmt.Sprintf("max(%s = '%s')", statusColumnName, domain.CollectionRunStatusOK), succeededAlias),
+		).
+		Where(
+			sb.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			sb.GreaterEqualThan(collectedAtColumnName, params.TimeRange.From.Unix()),
+			sb.LessThan(collectedAtColumnName, params.TimeRange.To.Unix()),
+		).
+		GroupBy("1")
+
+	buckets, err := selectRows[CollectionRunBucket](ctx, s.db, sb)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.SliceToMap(buckets, func(bucket CollectionRunBucket) (int64, bool) {
+		return bucket.CollectedAt, bucket.Succeeded
+	}), nil
+}
+
+// fillPoints returns points of every bucket of the time range, the newest first.
+// Missing buckets have status computed by collection runs, that are keyed by bucket unix time.
+func fillPoints(points []ServerStatisticPoint, runs map[int64]bool, params domain.ListServerStatisticsParams) []ServerStatisticPoint {
+	present := lo.SliceToMap(points, func(point ServerStatisticPoint) (int64, ServerStatisticPoint) {
+		return point.CollectedAt, point
+	})
+
+	var filled []ServerStatisticPoint
+
+	for bucket := params.Precision.BucketStart(params.TimeRange.From); bucket.Before(params.TimeRange.To); bucket = params.Precision.NextBucket(bucket) {
+		if point, ok := present[bucket.Unix()]; ok {
+			filled = append(filled, point)
+			continue
+		}
+
+		status := domain.ServerStatisticStatusUnknown
+		if succeeded, ok := runs[bucket.Unix()]; ok {
+			status = domain.ServerStatisticStatusCollectionFailed
+			if succeeded {
+				status = domain.ServerStatisticStatusOffline
+			}
+		}
+
+		filled = append(filled, ServerStatisticPoint{
+			Status:      string(status),
+			CollectedAt: bucket.Unix(),
+		})
+	}
+
+	return lo.Reverse(filled)
+}
+
+// ListServerMetadata returns the last known metadata of all servers of the multiplayer.
+func (s *Store) ListServerMetadata(ctx context.Context, multiplayer domain.Multiplayer) ([]Server, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversInfoTableName).
+		Select(
+			multiplayerColumnName, hostColumnName, nameColumnName, urlColumnName, gamemodeColumnName, languageColumnName,
+			maxPlayersColumnName, peakPlayersColumnName, mapColumnName, versionColumnName, passwordedColumnName, tagsColumnName, collectedAtColumnName,
+		).
+		Where(sb.Equal(multiplayerColumnName, string(multiplayer)))
+
+	return selectRows[Server](ctx, s.db, sb)
+}
+
+// InsertServerChanges ...
+func (s *Store) InsertServerChanges(ctx context.Context, changes []ServerChange) error {
+	for _, chunk := range lo.Chunk(changes, insertChunkSize) {
+		ib := sqlbuilder.NewInsertBuilder().
+			InsertInto(serversHistoryTableName).
+			Cols(multiplayerColumnName, hostColumnName, fieldColumnName, oldValueColumnName, newValueColumnName, changedAtColumnName)
+
+		for _, change := range chunk {
+			ib.Values(change.Multiplayer, change.Host, change.Field, change.OldValue, change.NewValue, change.ChangedAt)
+		}
+
+		ib.SQL("ON CONFLICT DO NOTHING")
+
+		sqlRaw, args := sql.BuildSQLite(ib)
+
+		if _, err := s.db.ExecContext(ctx, sqlRaw, args...); err != nil {
+			return fmt.Errorf("s.db.ExecContext: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ListServerChanges ...
+func (s *Store) ListServerChanges(ctx context.Context, params domain.ListServerChangesParams) ([]ServerChange, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversHistoryTableName).
+		Select(multiplayerColumnName, hostColumnName, fieldColumnName, oldValueColumnName, newValueColumnName, changedAtColumnName).
+		Where(
+			sb.Equal(multiplayerColumnName, string(params.Multiplayer)),
+			sb.Equal(hostColumnName, params.Host),
+		).
+		OrderByDesc(changedAtColumnName).
+		OrderByAsc(fieldColumnName).
+		Limit(int(params.Limit)).
+		Offset(int(params.Offset))
+
+	return selectRows[ServerChange](ctx, s.db, sb)
+}
+
+// GetServerSeen returns the first and the last time, that server was present in snapshots.
+func (s *Store) GetServerSeen(ctx context.Context, multiplayer domain.Multiplayer, host string) (ServerSeen, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	// rows are grouped, so unknown server has no rows instead of a row of nulls.
+	sb = sb.From(serversOnlineTableName).
+		Select(
+			sb.As(wrapColumn("min", collectedAtColumnName), firstSeenAtAlias),
+			sb.As(wrapColumn("max", collectedAtColumnName), lastSeenAtAlias),
+		).
+		Where(
+			sb.Equal(multiplayerColumnName, string(multiplayer)),
+			sb.Equal(hostColumnName, host),
+		).
+		GroupBy(multiplayerColumnName, hostColumnName)
+
+	seen, err := selectRows[ServerSeen](ctx, s.db, sb)
+	if err != nil {
+		return ServerSeen{}, err
+	}
+
+	if len(seen) == 0 {
+		return ServerSeen{}, dbsql.ErrNoRows
+	}
+
+	return seen[0], nil
+}
+
+// ListServerRunPresence returns successful collection runs of the multiplayer since from ordered by time,
+// with presence of the server in them.
+func (s *Store) ListServerRunPresence(ctx context.Context, multiplayer domain.Multiplayer, host string, from time.Time) ([]ServerRunPresence, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(collectionRunsTableName).
+		Select(
+			sb.As(collectionRunsTableName+"."+collectedAtColumnName, collectedAtColumnName),
+			sb.As(fmt.Sprintf("%s.%s IS NOT NULL", serversOnlineTableName, hostColumnName), presentAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			serversOnlineTableName,
+			sb.And(
+				fmt.Sprintf("%s.%s = %s.%s", serversOnlineTableName, multiplayerColumnName, collectionRunsTableName, multiplayerColumnName),
+				fmt.Sprintf("%s.%s = %s.%s", serversOnlineTableName, collectedAtColumnName, collectionRunsTableName, collectedAtColumnName),
+				sb.Equal(serversOnlineTableName+"."+hostColumnName, host),
+			),
+		).
+		Where(
+			sb.Equal(collectionRunsTableName+"."+multiplayerColumnName, string(multiplayer)),
+			sb.Equal(collectionRunsTableName+"."+statusColumnName, string(domain.CollectionRunStatusOK)),
+			sb.GreaterEqualThan(collectionRunsTableName+"."+collectedAtColumnName, from.Unix()),
+		).
+		OrderByAsc(collectedAtColumnName)
+
+	return selectRows[ServerRunPresence](ctx, s.db, sb)
+}
+
+// InsertCollectionRun ...
+func (s *Store) InsertCollectionRun(ctx context.Context, run CollectionRun) error {
+	ib := sqlbuilder.NewInsertBuilder()
+
+	ib = ib.InsertInto(collectionRunsTableName).
+		Cols(multiplayerColumnName, collectedAtColumnName, statusColumnName, serversCountColumnName, errorColumnName).
+		Values(run.Multiplayer, run.CollectedAt, run.Status, run.ServersCount, run.Error).
+		SQL(fmt.Sprintf("ON CONFLICT (%s, %s, %s) DO UPDATE SET %s = excluded.%s, %s = excluded.%s",
+			multiplayerColumnName, collectedAtColumnName, statusColumnName,
+			serversCountColumnName, serversCountColumnName, errorColumnName, errorColumnName,
+		))
+
+	sqlRaw, args := sql.BuildSQLite(ib)
+
+	if _, err := s.db.ExecContext(ctx, sqlRaw, args...); err != nil {
+		return fmt.Errorf("s.db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// selectRows scans rows into T by db tags of its fields.
+func selectRows[T any](ctx context.Context, db *dbsql.DB, builder sql.Builder) ([]T, error) {
+	sqlRaw, args := sql.BuildSQLite(builder)
+
+	rows, err := db.QueryContext(ctx, sqlRaw, args...)
+	if err != nil {
+		return nil, fmt.Errorf("db.QueryContext: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("rows.Columns: %w", err)
+	}
+
+	structOf := sqlbuilder.NewStruct(new(T))
+
+	var result []T
+
+	for rows.Next() {
+		var row T
+
+		addrs := structOf.AddrWithCols(columns, &row)
+		if addrs == nil {
+			return nil, fmt.Errorf("%w: %v", errUnknownColumn, columns)
+		}
+
+		if err = rows.Scan(addrs...); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		result = append(result, row)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return result, nil
+}
+
+// serverSummariesBuilder returns query of servers of the multiplayer with their current players count.
+// Servers missing from the latest snapshot are returned only with includeOffline.
+func (s *Store) serverSummariesBuilder(multiplayer domain.Multiplayer, includeOffline bool) *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb = sb.From(serversInfoTableName).
+		Select(
+			sb.As(serversInfoTableName+"."+hostColumnName, hostColumnName),
+			nameColumnName,
+			sb.As(onlinePlayersColumn(), playersCountColumnName),
+			sb.As(onlineColumn(), onlineAlias),
+			sb.As(serversInfoTableName+"."+collectedAtColumnName, lastSeenAtAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(s.latestOnlineBuilder(multiplayer), serversOnlineTableName),
+			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
+		).
+		Where(sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(multiplayer)))
+
+	if !includeOffline {
+		sb = sb.Where(onlineColumn())
+	}
+
+	return sb
+}
+
+// searchServersBuilder returns query of servers found by params with their current players count.
+func (s *Store) searchServersBuilder(params domain.SearchServersParams) *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	var (
+		playersColumn = onlinePlayersColumn()
+	)
+
+	conds := []string{sb.Equal(serversInfoTableName+"."+multiplayerColumnName, string(params.Multiplayer))}
+
+	if params.Query != "" {
+		conds = append(conds, fmt.Sprintf(`%s.%s LIKE %s ESCAPE '\'`, serversInfoTableName, nameColumnName, sb.Var("%"+escapeLike(params.Query)+"%")))
+	}
+
+	if params.Language != "" {
+		conds = append(conds, sb.Equal(languageColumnName, params.Language))
+	}
+
+	if params.Gamemode != "" {
+		conds = append(conds, sb.Equal(gamemodeColumnName, params.Gamemode))
+	}
+
+	if params.MinPlayers != nil {
+		conds = append(conds, sb.GreaterEqualThan(playersColumn, *params.MinPlayers))
+	}
+
+	if params.MaxPlayers != nil {
+		conds = append(conds, sb.LessEqualThan(playersColumn, *params.MaxPlayers))
+	}
+
+	switch params.Online {
+	case domain.ServerOnlineFilterOnline:
+		conds = append(conds, onlineColumn())
+	case domain.ServerOnlineFilterOffline:
+		conds = append(conds, "NOT "+onlineColumn())
+	case domain.ServerOnlineFilterAny:
+	}
+
+	return sb.From(serversInfoTableName).
+		Select(
+			sb.As(serversInfoTableName+"."+hostColumnName, hostColumnName),
+			nameColumnName,
+			languageColumnName,
+			gamemodeColumnName,
+			sb.As(playersColumn, playersCountColumnName),
+			sb.As(onlineColumn(), onlineAlias),
+			sb.As(serversInfoTableName+"."+collectedAtColumnName, lastSeenAtAlias),
+		).
+		JoinWithOption(
+			sqlbuilder.LeftJoin,
+			sb.BuilderAs(s.latestOnlineBuilder(params.Multiplayer), serversOnlineTableName),
+			fmt.Sprintf("%s.%s = %s.%s", serversInfoTableName, hostColumnName, serversOnlineTableName, hostColumnName),
+		).
+		Where(conds...)
+}
+
+// latestSnapshotsBuilder returns query of the latest snapshot time of every multiplayer.
+func (s *Store) latestSnapshotsBuilder() *sqlbuilder.SelectBuilder {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	return sb.From(serversOnlineTableName).
+		Select(multiplayerColumnName, wrapColumn("max", collectedAtColumnName)).
+		Where(sb.GreaterEqualThan(collectedAtColumnName, s.latestSnapshotMinTime())).
+		GroupBy(multiplayerColumnName)
+}
+
+// latestOnlineBuilder returns query of servers online of the multiplayer in its latest snapshot.
+func (s *Store) latestOnlineBuilder(multiplayer domain.Multiplayer) *sqlbuilder.SelectBuilder {
+	snapshot := sqlbuilder.NewSelectBuilder()
+	snapshot = snapshot.From(serversOnlineTableName).
+		Select(wrapColumn("max", collectedAtColumnName)).
+		Where(
+			snapshot.Equal(multiplayerColumnName, string(multiplayer)),
+			snapshot.GreaterEqualThan(collectedAtColumnName, s.latestSnapshotMinTime()),
+		)
+
+	sb := sqlbuilder.NewSelectBuilder()
+
+	return sb.From(serversOnlineTableName).
+		Select(hostColumnName, playersCountColumnName).
+		Where(
+			sb.Equal(multiplayerColumnName, string(multiplayer)),
+			fmt.Sprintf("%s = (%s)", collectedAtColumnName, sb.Var(snapshot)),
+		)
+}
+
+func (s *Store) latestSnapshotMinTime() int64 {
+	return time.Now().Add(-latestSnapshotMaxAge).Unix()
+}
+
+// onlineColumn returns condition, whether server of servers_info is present in the joined latest snapshot.
+func onlineColumn() string {
+	return fmt.Sprintf("%s.%s IS NOT NULL", serversOnlineTableName, hostColumnName)
+}
+
+// onlinePlayersColumn returns players count of the latest snapshot, that is zero for offline servers.
+func onlinePlayersColumn() string {
+	return fmt.Sprintf("coalesce(%s.%s, 0)", serversOnlineTableName, playersCountColumnName)
+}
+
+// bucketOf returns expression of the bucket start of unix time expression, buckets are aligned in UTC.
+// It must be consistent with domain.ServerStatisticsPrecision.BucketStart.
+func bucketOf(precision domain.ServerStatisticsPrecision, expr string) string {
+	switch precision {
+	case domain.ServerStatisticsPrecisionPerFiveMinutes:
+		return fmt.Sprintf("(%s - %s %% 300)", expr, expr)
+	case domain.ServerStatisticsPrecisionPerFifteenMinutes:
+		return fmt.Sprintf("(%s - %s %% 900)", expr, expr)
+	case domain.ServerStatisticsPrecisionPerDay:
+		return fmt.Sprintf("(%s - %s %% 86400)", expr, expr)
+	case domain.ServerStatisticsPrecisionPerWeek:
+		return fmt.Sprintf("(%s - (%s - %d) %% 604800)", expr, expr, mondayUnix)
+	case domain.ServerStatisticsPrecisionPerMonth:
+		return castInteger(fmt.Sprintf("strftime('%%s', %s, 'unixepoch', 'start of month')", expr))
+	default:
+		return fmt.Sprintf("(%s - %s %% 3600)", expr, expr)
+	}
+}
+
+// percentileOf returns expression of nearest-rank percentile of players count in the bucket.
+func percentileOf(percent int) string {
+	return fmt.Sprintf("coalesce(max(CASE WHEN %s = (%s * %d + 99) / 100 THEN %s END), 0)",
+		rowNumberAlias, bucketSizeAlias, percent, playersCountColumnName,
+	)
+}
+
+func castInteger(expr string) string {
+	return "CAST(" + expr + " AS INTEGER)"
+}
+
+// escapeLike escapes special characters of LIKE pattern.
+func escapeLike(value string) string {
+	return likeReplacer.Replace(value)
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func wrapColumn(wrapper, columnName string) string {
+	return wrapper + "(" + columnName + ")"
+}
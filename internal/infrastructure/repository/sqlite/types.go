@@ -0,0 +1,171 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package sqlite
+
+const (
+	serversInfoTableName    = "servers_info"
+	serversOnlineTableName  = "servers_online"
+	serversHistoryTableName = "servers_history"
+	collectionRunsTableName = "collection_runs"
+
+	multiplayerColumnName  = "multiplayer"
+	hostColumnName         = "host"
+	nameColumnName         = "name"
+	languageColumnName     = "language"
+	gamemodeColumnName     = "gamemode"
+	urlColumnName          = "url"
+	playersCountColumnName = "players_count"
+	maxPlayersColumnName   = "max_players"
+	peakPlayersColumnName  = "peak_players"
+	mapColumnName          = "map"
+	versionColumnName      = "version"
+	passwordedColumnName   = "passworded"
+	tagsColumnName         = "tags"
+	collectedAtColumnName  = "collected_at"
+	fieldColumnName        = "field"
+	oldValueColumnName     = "old_value"
+	newValueColumnName     = "new_value"
+	changedAtColumnName    = "changed_at"
+	statusColumnName       = "status"
+	serversCountColumnName = "servers_count"
+	errorColumnName        = "error"
+
+	minPlayersCountAlias = "min_players_count"
+	maxPlayersCountAlias = "max_players_count"
+	p50PlayersCountAlias = "p50_players_count"
+	p95PlayersCountAlias = "p95_players_count"
+	samplesCountAlias    = "samples_count"
+	succeededAlias       = "succeeded"
+	bucketAlias          = "bucket"
+	rowNumberAlias       = "row_number"
+	bucketSizeAlias      = "bucket_size"
+	playersBeforeAlias   = "players_before"
+	playersAfterAlias    = "players_after"
+	playersChangeAlias   = "players_change"
+	relativeChangeAlias  = "relative_change"
+	facetAlias           = "facet"
+	facetValueAlias      = "value"
+	facetCountAlias      = "count"
+	onlineAlias          = "online"
+	lastSeenAtAlias      = "last_seen_at"
+	firstSeenAtAlias     = "first_seen_at"
+	presentAlias         = "present"
+)
+
+// Server ...
+// Tags are JSON array and CollectedAt is unix time.
+type Server struct {
+	Multiplayer  string `db:"multiplayer"`
+	Host         string `db:"host"`
+	Name         string `db:"name"`
+	URL          string `db:"url"`
+	Gamemode     string `db:"gamemode"`
+	Language     string `db:"language"`
+	PlayersCount int32  `db:"players_count"`
+	MaxPlayers   int32  `db:"max_players"`
+	PeakPlayers  int32  `db:"peak_players"`
+	Map          string `db:"map"`
+	Version      string `db:"version"`
+	Passworded   *bool  `db:"passworded"`
+	Tags         string `db:"tags"`
+	CollectedAt  int64  `db:"collected_at"`
+}
+
+// MultiplayerSummary ...
+type MultiplayerSummary struct {
+	Multiplayer  string `db:"multiplayer"`
+	PlayersCount int64  `db:"players_count"`
+}
+
+// MultiplayerStatisticPoint ...
+type MultiplayerStatisticPoint struct {
+	PlayersCount int64 `db:"players_count"`
+	ServersCount int64 `db:"servers_count"`
+	CollectedAt  int64 `db:"collected_at"`
+}
+
+// ServerSummary ...
+// LastSeenAt is unix time of the latest snapshot, that server was present in.
+type ServerSummary struct {
+	Host         string `db:"host"`
+	Name         string `db:"name"`
+	PlayersCount int32  `db:"players_count"`
+	Online       bool   `db:"online"`
+	LastSeenAt   int64  `db:"last_seen_at"`
+}
+
+// Facets of ServerFacetValue.
+const (
+	FacetLanguage = "language"
+	FacetGamemode = "gamemode"
+)
+
+// ServerFacetValue ...
+type ServerFacetValue struct {
+	Facet string `db:"facet"`
+	Value string `db:"value"`
+	Count int64  `db:"count"`
+}
+
+// TrendingServer ...
+type TrendingServer struct {
+	Host           string   `db:"host"`
+	Name           string   `db:"name"`
+	PlayersBefore  float64  `db:"players_before"`
+	PlayersAfter   float64  `db:"players_after"`
+	PlayersChange  float64  `db:"players_change"`
+	RelativeChange *float64 `db:"relative_change"`
+}
+
+// ServerStatisticPoint ...
+// Status is one of domain.ServerStatisticStatus values, it is computed by collection runs for missing buckets.
+type ServerStatisticPoint struct {
+	Status          string `db:"-"`
+	PlayersCount    int32  `db:"players_count"`
+	MinPlayersCount int32  `db:"min_players_count"`
+	MaxPlayersCount int32  `db:"max_players_count"`
+	P50PlayersCount int32  `db:"p50_players_count"`
+	P95PlayersCount int32  `db:"p95_players_count"`
+	SamplesCount    int64  `db:"samples_count"`
+	CollectedAt     int64  `db:"collected_at"`
+}
+
+// CollectionRunBucket ...
+type CollectionRunBucket struct {
+	CollectedAt int64 `db:"collected_at"`
+	Succeeded   bool  `db:"succeeded"`
+}
+
+// ServerChange ...
+type ServerChange struct {
+	Multiplayer string `db:"multiplayer"`
+	Host        string `db:"host"`
+	Field       string `db:"field"`
+	OldValue    string `db:"old_value"`
+	NewValue    string `db:"new_value"`
+	ChangedAt   int64  `db:"changed_at"`
+}
+
+// CollectionRun ...
+type CollectionRun struct {
+	Multiplayer  string
+	CollectedAt  int64
+	Status       string
+	ServersCount int32
+	Error        string
+}
+
+// ServerSeen ...
+// Times are unix times.
+type ServerSeen struct {
+	FirstSeenAt int64 `db:"first_seen_at"`
+	LastSeenAt  int64 `db:"last_seen_at"`
+}
+
+// ServerRunPresence ...
+// CollectedAt is unix time.
+type ServerRunPresence struct {
+	CollectedAt int64 `db:"collected_at"`
+	Present     bool  `db:"present"`
+}
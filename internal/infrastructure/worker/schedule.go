@@ -0,0 +1,80 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule decides when worker runs.
+type Schedule interface {
+	// Next returns the next run time after t.
+	Next(t time.Time) time.Time
+	// Prev returns the latest run time not after t.
+	Prev(t time.Time) time.Time
+}
+
+// maxCronPeriod is the longest period between cron runs, that Prev looks back for, cron expression may fire once a year.
+const maxCronPeriod = 366 * 24 * time.Hour
+
+// Every returns Schedule, that runs every interval aligned to wall clock,
+// for example every 15 minutes runs at :00, :15, :30 and :45.
+func Every(interval time.Duration) Schedule {
+	return everySchedule{interval: interval}
+}
+
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Truncate(s.interval).Add(s.interval)
+}
+
+func (s everySchedule) Prev(t time.Time) time.Time {
+	return t.Truncate(s.interval)
+}
+
+// ParseCron returns Schedule from standard 5 fields cron expression or descriptor, like "@daily" or "@every 1h30m".
+// Time zone may be set with "CRON_TZ=" prefix, UTC is used otherwise.
+func ParseCron(expr string) (Schedule, error) {
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("cron.ParseStandard: %w", err)
+	}
+
+	return cronSchedule{schedule: schedule}, nil
+}
+
+type cronSchedule struct {
+	schedule cron.Schedule
+}
+
+func (s cronSchedule) Next(t time.Time) time.Time {
+	return s.schedule.Next(t.UTC())
+}
+
+// Prev looks back for a run with doubling period, because cron has no reverse iteration.
+func (s cronSchedule) Prev(t time.Time) time.Time {
+	t = t.UTC()
+
+	for period := time.Minute; period <= 2*maxCronPeriod; period *= 2 {
+		run := s.schedule.Next(t.Add(-period))
+		if run.After(t) {
+			continue
+		}
+
+		for next := s.schedule.Next(run); !next.After(t); next = s.schedule.Next(next) {
+			run = next
+		}
+
+		return run
+	}
+
+	// expression never fires, e.g. "0 0 30 2 *".
+	return t.Truncate(time.Minute)
+}
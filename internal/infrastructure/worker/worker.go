@@ -5,35 +5,72 @@ package worker
 
 import (
 	"context"
-	"sync"
+	"math/rand/v2"
 	"time"
 
 	"go.uber.org/zap"
 )
 
-// WorkFunc ...
-type WorkFunc func(ctx context.Context) error
+// WorkFunc is called on every tick, tick is a scheduled time of the run without jitter.
+type WorkFunc func(ctx context.Context, tick time.Time) error
+
+// Lease claims ticks, so only one of the replicas runs each tick of the worker.
+type Lease interface {
+	// Claim returns true, if tick of the job is claimed by the current replica.
+	Claim(ctx context.Context, job string, tick time.Time) (bool, error)
+}
 
 // Worker ...
 type Worker struct {
-	interval time.Duration
-	workFunc WorkFunc
+	name       string
+	lease      Lease
+	schedule   Schedule
+	jitter     time.Duration
+	runOnStart bool
+	maxRuntime time.Duration
+	workFunc   WorkFunc
 
 	logger *zap.Logger
 }
 
-// New returns new Worker.
-func New(name string, interval time.Duration, workFunc WorkFunc, logger *zap.Logger) *Worker {
-	if logger == nil {
-		logger = zap.L()
+// Opts ...
+type Opts struct {
+	// Schedule of the runs, required.
+	Schedule Schedule
+	// Jitter is a maximum random delay, that is added to every scheduled run.
+	Jitter time.Duration
+	// RunOnStart runs work func immediately after start with the tick of the latest scheduled run,
+	// so it is skipped by the lease, when that run is already done by another replica.
+	RunOnStart bool
+	// MaxRuntime is a timeout of a single run, zero means no timeout.
+	MaxRuntime time.Duration
+	// Lease is an optional lease, that is claimed before every run.
+	Lease Lease
+
+	Logger *zap.Logger
+}
+
+func (o *Opts) setDefaults() {
+	if o.Logger == nil {
+		o.Logger = zap.L()
 	}
+}
+
+// New returns new Worker.
+func New(name string, workFunc WorkFunc, opts Opts) *Worker {
+	opts.setDefaults()
 
-	logger = logger.Named("async-worker")
+	logger := opts.Logger.Named("async-worker")
 	logger = logger.With(zap.String("name", name))
 
 	return &Worker{
-		interval: interval,
-		workFunc: workFunc,
+		name:       name,
+		lease:      opts.Lease,
+		schedule:   opts.Schedule,
+		jitter:     opts.Jitter,
+		runOnStart: opts.RunOnStart,
+		maxRuntime: opts.MaxRuntime,
+		workFunc:   workFunc,
 
 		logger: logger,
 	}
@@ -41,34 +78,58 @@ func New(name string, interval time.Duration, workFunc WorkFunc, logger *zap.Log
 
 // Run ...
 func (w *Worker) Run(ctx context.Context) error {
-	timer := time.NewTimer(w.nextRunAfter())
-	defer timer.Stop()
-
-	var wg sync.WaitGroup
-
 	w.logger.Info("starting worker")
 
+	if w.runOnStart {
+		w.work(ctx, w.schedule.Prev(time.Now()))
+	}
+
 	for {
-		select {
-		case <-timer.C:
-			wg.Add(1)
+		now := time.Now()
+		tick := w.schedule.Next(now)
 
-			if err := w.workFunc(ctx); err != nil {
-				w.logger.Error("work func call was failed", zap.Error(err))
-			}
+		timer := time.NewTimer(tick.Sub(now) + w.randomJitter())
 
-			wg.Done()
-			timer.Reset(w.nextRunAfter())
+		select {
+		case <-timer.C:
+			w.work(ctx, tick)
 		case <-ctx.Done():
-			w.logger.Info("stopping worker")
-			wg.Wait() // TODO: add timeout?
+			timer.Stop()
 			w.logger.Info("worker has been stopped")
 			return nil
 		}
 	}
 }
 
-func (w *Worker) nextRunAfter() time.Duration {
-	now := time.Now()
-	return now.Truncate(w.interval).Add(w.interval).Sub(now)
+func (w *Worker) work(ctx context.Context, tick time.Time) {
+	if w.lease != nil {
+		claimed, err := w.lease.Claim(ctx, w.name, tick)
+		if err != nil {
+			w.logger.Error("failed to claim lease, run is skipped", zap.Time("tick", tick), zap.Error(err))
+			return
+		}
+
+		if !claimed {
+			w.logger.Debug("tick is claimed by another replica, run is skipped", zap.Time("tick", tick))
+			return
+		}
+	}
+
+	if w.maxRuntime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.maxRuntime)
+		defer cancel()
+	}
+
+	if err := w.workFunc(ctx, tick); err != nil {
+		w.logger.Error("work func call was failed", zap.Error(err))
+	}
+}
+
+func (w *Worker) randomJitter() time.Duration {
+	if w.jitter <= 0 {
+		return 0
+	}
+
+	return rand.N(w.jitter) //nolint:gosec
 }
@@ -0,0 +1,116 @@
+// Copyright 2025 Stepan Rabotkin.
+// SPDX-License-Identifier: Apache-2.0.
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 10, 12, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule func() (Schedule, error)
+		wantNext time.Time
+		wantPrev time.Time
+	}{
+		{
+			name: "Every",
+			schedule: func() (Schedule, error) {
+				return Every(15 * time.Minute), nil
+			},
+			wantNext: time.Date(2025, 3, 10, 12, 15, 0, 0, time.UTC),
+			wantPrev: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Cron",
+			schedule: func() (Schedule, error) {
+				return ParseCron("5 */2 * * *")
+			},
+			wantNext: time.Date(2025, 3, 10, 14, 5, 0, 0, time.UTC),
+			wantPrev: time.Date(2025, 3, 10, 12, 5, 0, 0, time.UTC),
+		},
+		{
+			name: "CronDescriptor",
+			schedule: func() (Schedule, error) {
+				return ParseCron("@daily")
+			},
+			wantNext: time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "CronYearly",
+			schedule: func() (Schedule, error) {
+				return ParseCron("@yearly")
+			},
+			wantNext: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantPrev: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := tt.schedule()
+			require.NoError(t, err)
+			assert.True(t, tt.wantNext.Equal(schedule.Next(now)), "got %s", schedule.Next(now))
+			assert.True(t, tt.wantPrev.Equal(schedule.Prev(now)), "got %s", schedule.Prev(now))
+			// run time is the previous run of itself.
+			assert.True(t, tt.wantPrev.Equal(schedule.Prev(tt.wantPrev)), "got %s", schedule.Prev(tt.wantPrev))
+		})
+	}
+
+	_, err := ParseCron("bad")
+	assert.Error(t, err)
+}
+
+type neverSchedule struct{}
+
+func (neverSchedule) Next(t time.Time) time.Time {
+	return t.Add(time.Hour)
+}
+
+func (neverSchedule) Prev(t time.Time) time.Time {
+	return t.Truncate(time.Hour)
+}
+
+func TestWorkerRunOnStart(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	var calls atomic.Int32
+
+	w := New("test", func(ctx context.Context, tick time.Time) error {
+		calls.Add(1)
+
+		assert.Equal(t, time.Now().Truncate(time.Hour), tick)
+
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+		cancel()
+
+		return nil
+	}, Opts{
+		Schedule:   neverSchedule{},
+		RunOnStart: true,
+		MaxRuntime: time.Minute,
+	})
+
+	require.NoError(t, w.Run(ctx))
+	assert.Equal(t, int32(1), calls.Load())
+}
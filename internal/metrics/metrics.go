@@ -13,6 +13,7 @@ import (
 const (
 	namespaceName                     = "gdatum"
 	serverStatsCollectorSubsystemName = "servers_stats_collector"
+	egressSubsystemName               = "egress"
 )
 
 // CollectorMetrics is a metrics for collector.
@@ -73,3 +74,32 @@ func (m *CollectorMetrics) RecordCollectionError(multiplayer domain.Multiplayer)
 func (m *CollectorMetrics) RecordInsertError() {
 	m.insertErrorsTotal.Inc()
 }
+
+// EgressMetrics is a metrics for egress client.
+type EgressMetrics struct {
+	notModifiedTotal *prometheus.CounterVec
+}
+
+// NewEgressMetrics ...
+func NewEgressMetrics(registerer prometheus.Registerer) *EgressMetrics {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	factory := promauto.With(registerer)
+	return &EgressMetrics{
+		notModifiedTotal: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespaceName,
+				Subsystem: egressSubsystemName,
+				Name:      "not_modified_total",
+				Help:      "Total number of upstream responses, that were not modified and served from cache",
+			},
+			[]string{"host"}),
+	}
+}
+
+// RecordNotModified ...
+func (m *EgressMetrics) RecordNotModified(host string) {
+	m.notModifiedTotal.WithLabelValues(host).Inc()
+}
@@ -5,39 +5,89 @@ package migrations
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io/fs"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
+	_ "modernc.org/sqlite" // registers sqlite driver
+
+	"github.com/EpicStep/gdatum/internal/config"
 )
 
 const (
-	gooseDialect       = "clickhouse"
-	gooseMigrationsDir = "migrations"
+	gooseMigrationsDir            = "migrations"
+	gooseMigrationsPostgresDir    = "migrations/postgres"
+	gooseMigrationsTimescaleDBDir = "migrations/timescaledb"
+	gooseMigrationsSQLiteDir      = "migrations/sqlite"
+
+	// gooseTimescaleDBTableName is a version table of TimescaleDB migrations, that are applied on top of Postgres ones.
+	gooseTimescaleDBTableName = "goose_db_version_timescaledb"
 )
 
-// Run migrations on provided dsn.
-func Run(ctx context.Context, dsn string, fSys fs.FS) error {
-	opts, err := clickhouse.ParseDSN(dsn)
-	if err != nil {
-		return fmt.Errorf("clickhouse.ParseDSN: %w", err)
-	}
+// Run migrations of the database driver (see config.DatabaseDriver) on provided dsn.
+func Run(ctx context.Context, driver, dsn string, fSys fs.FS) error {
+	switch driver {
+	case config.DatabaseDriverPostgres, config.DatabaseDriverTimescaleDB:
+		connConfig, err := pgx.ParseConfig(dsn)
+		if err != nil {
+			return fmt.Errorf("pgx.ParseConfig: %w", err)
+		}
+
+		db := stdlib.OpenDB(*connConfig)
+		defer db.Close() //nolint:errcheck
+
+		if err = up(ctx, db, goose.DialectPostgres, fSys, gooseMigrationsPostgresDir); err != nil {
+			return err
+		}
+
+		if driver != config.DatabaseDriverTimescaleDB {
+			return nil
+		}
+
+		return up(ctx, db, goose.DialectPostgres, fSys, gooseMigrationsTimescaleDBDir, goose.WithTableName(gooseTimescaleDBTableName))
+	case config.DatabaseDriverSQLite:
+		db, err := sql.Open("sqlite", dsn)
+		if err != nil {
+			return fmt.Errorf("sql.Open: %w", err)
+		}
+		defer db.Close() //nolint:errcheck
 
-	db := clickhouse.OpenDB(opts)
+		return up(ctx, db, goose.DialectSQLite3, fSys, gooseMigrationsSQLiteDir)
+	default:
+		opts, err := clickhouse.ParseDSN(dsn)
+		if err != nil {
+			return fmt.Errorf("clickhouse.ParseDSN: %w", err)
+		}
 
-	if err = db.PingContext(ctx); err != nil {
+		db := clickhouse.OpenDB(opts)
+		defer db.Close() //nolint:errcheck
+
+		return up(ctx, db, goose.DialectClickHouse, fSys, gooseMigrationsDir)
+	}
+}
+
+// up applies migrations of dir with own goose provider, so concurrent runs don't share goose global state.
+func up(ctx context.Context, db *sql.DB, dialect goose.Dialect, fSys fs.FS, dir string, opts ...goose.ProviderOption) error {
+	if err := db.PingContext(ctx); err != nil {
 		return fmt.Errorf("db.Ping: %w", err)
 	}
 
-	goose.SetBaseFS(fSys)
+	dirFS, err := fs.Sub(fSys, dir)
+	if err != nil {
+		return fmt.Errorf("fs.Sub: %w", err)
+	}
 
-	if err = goose.SetDialect(gooseDialect); err != nil {
-		return fmt.Errorf("goose.SetDialect: %w", err)
+	provider, err := goose.NewProvider(dialect, db, dirFS, opts...)
+	if err != nil {
+		return fmt.Errorf("goose.NewProvider: %w", err)
 	}
 
-	if err = goose.UpContext(ctx, db, gooseMigrationsDir); err != nil {
-		return fmt.Errorf("goose.Up: %w", err)
+	if _, err = provider.Up(ctx); err != nil {
+		return fmt.Errorf("provider.Up: %w", err)
 	}
 
 	return nil
@@ -14,3 +14,13 @@ type Builder interface {
 func Build(b Builder) (string, []any) {
 	return b.BuildWithFlavor(sqlbuilder.ClickHouse)
 }
+
+// BuildPostgreSQL builds query with PostgreSQL flavor.
+func BuildPostgreSQL(b Builder) (string, []any) {
+	return b.BuildWithFlavor(sqlbuilder.PostgreSQL)
+}
+
+// BuildSQLite builds query with SQLite flavor.
+func BuildSQLite(b Builder) (string, []any) {
+	return b.BuildWithFlavor(sqlbuilder.SQLite)
+}
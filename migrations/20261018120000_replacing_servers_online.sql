@@ -0,0 +1,90 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP TABLE servers_online_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE servers_online_replacing
+(
+    multiplayer  LowCardinality(String),
+    host         String,
+    players_count Int32 CODEC(T64, ZSTD),
+    collected_at  Datetime CODEC(DoubleDelta, ZSTD)
+) ENGINE = ReplacingMergeTree()
+      ORDER BY (host, multiplayer, collected_at)
+      PARTITION BY toYYYYMM(collected_at)
+      SETTINGS non_replicated_deduplication_window = 1000;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_online_replacing
+SELECT multiplayer, host, players_count, collected_at
+FROM servers_online;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_online;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+RENAME TABLE servers_online_replacing TO servers_online;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info MODIFY SETTING non_replicated_deduplication_window = 1000;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_online_mv TO servers_online AS
+SELECT multiplayer,
+       host,
+       players_count,
+       collected_at
+FROM servers_metrics_raw
+GROUP BY multiplayer, host, players_count, collected_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE servers_online_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE servers_online_merge
+(
+    multiplayer  LowCardinality(String),
+    host         String,
+    players_count Int32 CODEC(T64, ZSTD),
+    collected_at  Datetime CODEC(DoubleDelta, ZSTD)
+) ENGINE = MergeTree()
+      ORDER BY (host, multiplayer, collected_at)
+      PARTITION BY toYYYYMM(collected_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_online_merge
+SELECT multiplayer, host, players_count, collected_at
+FROM servers_online FINAL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_online;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+RENAME TABLE servers_online_merge TO servers_online;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info RESET SETTING non_replicated_deduplication_window;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_online_mv TO servers_online AS
+SELECT multiplayer,
+       host,
+       players_count,
+       collected_at
+FROM servers_metrics_raw
+GROUP BY multiplayer, host, players_count, collected_at;
+-- +goose StatementEnd
@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE servers_metrics_raw
+    ADD COLUMN max_players Int32 AFTER players_count,
+    ADD COLUMN version String AFTER max_players,
+    ADD COLUMN passworded Bool AFTER version,
+    ADD COLUMN tags Array(String) AFTER passworded;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    ADD COLUMN max_players Int32 AFTER language,
+    ADD COLUMN version String AFTER max_players,
+    ADD COLUMN passworded Bool AFTER version,
+    ADD COLUMN tags Array(String) AFTER passworded;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_info_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_info_mv TO servers_info AS
+SELECT multiplayer,
+       host,
+       name,
+       url,
+       gamemode,
+       language,
+       max_players,
+       version,
+       passworded,
+       tags,
+       collected_at
+FROM servers_metrics_raw
+GROUP BY multiplayer, host, name, url, gamemode, language, max_players, version, passworded, tags, collected_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE servers_info_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_info_mv TO servers_info AS
+SELECT multiplayer,
+       host,
+       name,
+       url,
+       gamemode,
+       language,
+       collected_at
+FROM servers_metrics_raw
+GROUP BY multiplayer, host, name, url, gamemode, language, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    DROP COLUMN max_players,
+    DROP COLUMN version,
+    DROP COLUMN passworded,
+    DROP COLUMN tags;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_metrics_raw
+    DROP COLUMN max_players,
+    DROP COLUMN version,
+    DROP COLUMN passworded,
+    DROP COLUMN tags;
+-- +goose StatementEnd
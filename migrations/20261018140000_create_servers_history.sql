@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE servers_history
+(
+    multiplayer LowCardinality(String),
+    host        String,
+    field       LowCardinality(String),
+    old_value   String,
+    new_value   String,
+    changed_at  Datetime
+) ENGINE = ReplacingMergeTree()
+      ORDER BY (multiplayer, host, changed_at, field)
+      PARTITION BY toYYYYMM(changed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE servers_history;
+-- +goose StatementEnd
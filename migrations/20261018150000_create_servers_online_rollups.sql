@@ -0,0 +1,132 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE servers_online_daily
+(
+    multiplayer       LowCardinality(String),
+    host              String,
+    collected_at      Date,
+    players_avg       AggregateFunction(avg, Int32),
+    players_min       AggregateFunction(min, Int32),
+    players_max       AggregateFunction(max, Int32),
+    players_quantiles AggregateFunction(quantiles(0.5, 0.9, 0.99), Int32)
+) ENGINE = AggregatingMergeTree()
+      ORDER BY (host, multiplayer, collected_at)
+      PARTITION BY toYYYYMM(collected_at)
+      TTL collected_at + INTERVAL 2 YEAR;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE servers_online_monthly
+(
+    multiplayer       LowCardinality(String),
+    host              String,
+    collected_at      Date,
+    players_avg       AggregateFunction(avg, Int32),
+    players_min       AggregateFunction(min, Int32),
+    players_max       AggregateFunction(max, Int32),
+    players_quantiles AggregateFunction(quantiles(0.5, 0.9, 0.99), Int32)
+) ENGINE = AggregatingMergeTree()
+      ORDER BY (host, multiplayer, collected_at)
+      PARTITION BY toYear(collected_at);
+-- +goose StatementEnd
+
+-- rollups are fed by the MVs from the cutoff and backfilled before it, so snapshots inserted meanwhile are not
+-- rolled up twice. collected_at is qualified, because it is shadowed by the alias of the day.
+-- +goose StatementBegin
+CREATE TABLE servers_online_rollups_cutoffs
+(
+    name   LowCardinality(String),
+    cutoff Datetime
+) ENGINE = MergeTree()
+      ORDER BY name;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_online_rollups_cutoffs
+SELECT 'rollups', now();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_online_daily_mv TO servers_online_daily AS
+SELECT multiplayer,
+       host,
+       toDate(collected_at)                          AS collected_at,
+       avgState(players_count)                       AS players_avg,
+       minState(players_count)                       AS players_min,
+       maxState(players_count)                       AS players_max,
+       quantilesState(0.5, 0.9, 0.99)(players_count) AS players_quantiles
+FROM servers_online
+WHERE servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_online_monthly_mv TO servers_online_monthly AS
+SELECT multiplayer,
+       host,
+       toStartOfMonth(collected_at)                  AS collected_at,
+       avgState(players_count)                       AS players_avg,
+       minState(players_count)                       AS players_min,
+       maxState(players_count)                       AS players_max,
+       quantilesState(0.5, 0.9, 0.99)(players_count) AS players_quantiles
+FROM servers_online
+WHERE servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_online_daily
+SELECT multiplayer,
+       host,
+       toDate(collected_at)                          AS collected_at,
+       avgState(players_count)                       AS players_avg,
+       minState(players_count)                       AS players_min,
+       maxState(players_count)                       AS players_max,
+       quantilesState(0.5, 0.9, 0.99)(players_count) AS players_quantiles
+FROM servers_online FINAL
+WHERE servers_online.collected_at < (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_online_monthly
+SELECT multiplayer,
+       host,
+       toStartOfMonth(collected_at)                  AS collected_at,
+       avgState(players_count)                       AS players_avg,
+       minState(players_count)                       AS players_min,
+       maxState(players_count)                       AS players_max,
+       quantilesState(0.5, 0.9, 0.99)(players_count) AS players_quantiles
+FROM servers_online FINAL
+WHERE servers_online.collected_at < (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online MODIFY TTL collected_at + INTERVAL 90 DAY;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers_online REMOVE TTL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_online_monthly_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_online_daily_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_online_rollups_cutoffs;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_online_monthly;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_online_daily;
+-- +goose StatementEnd
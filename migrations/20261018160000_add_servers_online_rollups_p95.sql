@@ -0,0 +1,119 @@
+-- +goose Up
+-- MVs are modified in place, so no snapshot is missed, and count p95 from the own cutoff, that the backfill stops at.
+-- +goose StatementBegin
+ALTER TABLE servers_online_daily
+    ADD COLUMN players_p95 AggregateFunction(quantile(0.95), Int32),
+    ADD COLUMN samples SimpleAggregateFunction(sum, UInt64);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online_monthly
+    ADD COLUMN players_p95 AggregateFunction(quantile(0.95), Int32),
+    ADD COLUMN samples SimpleAggregateFunction(sum, UInt64);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_online_rollups_cutoffs
+SELECT 'rollups_p95', now();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online_daily_mv MODIFY QUERY
+SELECT multiplayer,
+       host,
+       toDate(collected_at)                          AS collected_at,
+       avgState(players_count)                       AS players_avg,
+       minState(players_count)                       AS players_min,
+       maxState(players_count)                       AS players_max,
+       quantilesState(0.5, 0.9, 0.99)(players_count) AS players_quantiles,
+       quantileStateIf(0.95)(players_count, servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups_p95')) AS players_p95,
+       countIf(servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups_p95')) AS samples
+FROM servers_online
+WHERE servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online_monthly_mv MODIFY QUERY
+SELECT multiplayer,
+       host,
+       toStartOfMonth(collected_at)                  AS collected_at,
+       avgState(players_count)                       AS players_avg,
+       minState(players_count)                       AS players_min,
+       maxState(players_count)                       AS players_max,
+       quantilesState(0.5, 0.9, 0.99)(players_count) AS players_quantiles,
+       quantileStateIf(0.95)(players_count, servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups_p95')) AS players_p95,
+       countIf(servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups_p95')) AS samples
+FROM servers_online
+WHERE servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_online_daily (multiplayer, host, collected_at, players_p95, samples)
+SELECT multiplayer,
+       host,
+       toDate(collected_at)               AS collected_at,
+       quantileState(0.95)(players_count) AS players_p95,
+       count()                            AS samples
+FROM servers_online FINAL
+WHERE servers_online.collected_at < (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups_p95')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_online_monthly (multiplayer, host, collected_at, players_p95, samples)
+SELECT multiplayer,
+       host,
+       toStartOfMonth(collected_at)       AS collected_at,
+       quantileState(0.95)(players_count) AS players_p95,
+       count()                            AS samples
+FROM servers_online FINAL
+WHERE servers_online.collected_at < (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups_p95')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers_online_daily_mv MODIFY QUERY
+SELECT multiplayer,
+       host,
+       toDate(collected_at)                          AS collected_at,
+       avgState(players_count)                       AS players_avg,
+       minState(players_count)                       AS players_min,
+       maxState(players_count)                       AS players_max,
+       quantilesState(0.5, 0.9, 0.99)(players_count) AS players_quantiles
+FROM servers_online
+WHERE servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online_monthly_mv MODIFY QUERY
+SELECT multiplayer,
+       host,
+       toStartOfMonth(collected_at)                  AS collected_at,
+       avgState(players_count)                       AS players_avg,
+       minState(players_count)                       AS players_min,
+       maxState(players_count)                       AS players_max,
+       quantilesState(0.5, 0.9, 0.99)(players_count) AS players_quantiles
+FROM servers_online
+WHERE servers_online.collected_at >= (SELECT cutoff FROM servers_online_rollups_cutoffs WHERE name = 'rollups')
+GROUP BY multiplayer, host, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online_daily
+    DROP COLUMN players_p95,
+    DROP COLUMN samples;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online_monthly
+    DROP COLUMN players_p95,
+    DROP COLUMN samples;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DELETE FROM servers_online_rollups_cutoffs WHERE name = 'rollups_p95';
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE collection_runs
+(
+    multiplayer   LowCardinality(String),
+    collected_at  Datetime,
+    status        LowCardinality(String),
+    servers_count Int32,
+    error         String
+) ENGINE = ReplacingMergeTree()
+      ORDER BY (multiplayer, collected_at, status)
+      PARTITION BY toYYYYMM(collected_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE collection_runs;
+-- +goose StatementEnd
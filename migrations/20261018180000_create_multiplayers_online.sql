@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE multiplayers_online
+(
+    multiplayer   LowCardinality(String),
+    collected_at  Datetime CODEC(DoubleDelta, ZSTD),
+    players_count SimpleAggregateFunction(sum, Int64),
+    servers_count SimpleAggregateFunction(sum, UInt64)
+) ENGINE = AggregatingMergeTree()
+      ORDER BY (multiplayer, collected_at)
+      PARTITION BY toYear(collected_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW multiplayers_online_mv TO multiplayers_online AS
+SELECT multiplayer,
+       collected_at,
+       sum(toInt64(players_count)) AS players_count,
+       count()                     AS servers_count
+FROM servers_online
+GROUP BY multiplayer, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO multiplayers_online
+SELECT multiplayer,
+       collected_at,
+       sum(toInt64(players_count)) AS players_count,
+       count()                     AS servers_count
+FROM servers_online FINAL
+GROUP BY multiplayer, collected_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE multiplayers_online_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE multiplayers_online;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    ADD INDEX name_ngram_idx lowerUTF8(name) TYPE ngrambf_v1(3, 1024, 3, 0) GRANULARITY 4,
+    ADD INDEX name_token_idx lowerUTF8(name) TYPE tokenbf_v1(1024, 3, 0) GRANULARITY 4,
+    ADD INDEX language_idx language TYPE bloom_filter GRANULARITY 4,
+    ADD INDEX gamemode_idx gamemode TYPE bloom_filter GRANULARITY 4;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info MATERIALIZE INDEX name_ngram_idx;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info MATERIALIZE INDEX name_token_idx;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info MATERIALIZE INDEX language_idx;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info MATERIALIZE INDEX gamemode_idx;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    DROP INDEX name_ngram_idx,
+    DROP INDEX name_token_idx,
+    DROP INDEX language_idx,
+    DROP INDEX gamemode_idx;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE servers_seen
+(
+    multiplayer   LowCardinality(String),
+    host          String,
+    first_seen_at SimpleAggregateFunction(min, Datetime),
+    last_seen_at  SimpleAggregateFunction(max, Datetime)
+) ENGINE = AggregatingMergeTree()
+      ORDER BY (multiplayer, host);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_seen_mv TO servers_seen AS
+SELECT multiplayer,
+       host,
+       min(collected_at) AS first_seen_at,
+       max(collected_at) AS last_seen_at
+FROM servers_online
+GROUP BY multiplayer, host;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_seen
+SELECT multiplayer,
+       host,
+       min(collected_at) AS first_seen_at,
+       max(collected_at) AS last_seen_at
+FROM servers_online
+GROUP BY multiplayer, host;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE servers_seen_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_seen;
+-- +goose StatementEnd
@@ -0,0 +1,69 @@
+-- +goose Up
+-- passworded becomes nullable, as not every platform reports it.
+-- +goose StatementBegin
+ALTER TABLE servers_metrics_raw
+    ADD COLUMN peak_players Int32 AFTER max_players,
+    MODIFY COLUMN passworded Nullable(Bool);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    ADD COLUMN peak_players Int32 AFTER max_players,
+    MODIFY COLUMN passworded Nullable(Bool);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_info_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_info_mv TO servers_info AS
+SELECT multiplayer,
+       host,
+       name,
+       url,
+       gamemode,
+       language,
+       max_players,
+       peak_players,
+       version,
+       passworded,
+       tags,
+       collected_at
+FROM servers_metrics_raw
+GROUP BY multiplayer, host, name, url, gamemode, language, max_players, peak_players, version, passworded, tags, collected_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE servers_info_mv;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_info_mv TO servers_info AS
+SELECT multiplayer,
+       host,
+       name,
+       url,
+       gamemode,
+       language,
+       max_players,
+       version,
+       passworded,
+       tags,
+       collected_at
+FROM servers_metrics_raw
+GROUP BY multiplayer, host, name, url, gamemode, language, max_players, version, passworded, tags, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    DROP COLUMN peak_players,
+    MODIFY COLUMN passworded Bool;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_metrics_raw
+    DROP COLUMN peak_players,
+    MODIFY COLUMN passworded Bool;
+-- +goose StatementEnd
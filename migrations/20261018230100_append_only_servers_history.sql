@@ -0,0 +1,59 @@
+-- +goose Up
+-- history is append-only, retried inserts of the same snapshot are deduplicated by insert token.
+-- +goose StatementBegin
+CREATE TABLE servers_history_append
+(
+    multiplayer LowCardinality(String),
+    host        String,
+    field       LowCardinality(String),
+    old_value   String,
+    new_value   String,
+    changed_at  Datetime
+) ENGINE = MergeTree()
+      ORDER BY (multiplayer, host, changed_at, field)
+      PARTITION BY toYYYYMM(changed_at)
+      SETTINGS non_replicated_deduplication_window = 1000;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_history_append
+SELECT multiplayer, host, field, old_value, new_value, changed_at
+FROM servers_history FINAL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_history;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+RENAME TABLE servers_history_append TO servers_history;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE servers_history_replacing
+(
+    multiplayer LowCardinality(String),
+    host        String,
+    field       LowCardinality(String),
+    old_value   String,
+    new_value   String,
+    changed_at  Datetime
+) ENGINE = ReplacingMergeTree()
+      ORDER BY (multiplayer, host, changed_at, field)
+      PARTITION BY toYYYYMM(changed_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_history_replacing
+SELECT multiplayer, host, field, old_value, new_value, changed_at
+FROM servers_history;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_history;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+RENAME TABLE servers_history_replacing TO servers_history;
+-- +goose StatementEnd
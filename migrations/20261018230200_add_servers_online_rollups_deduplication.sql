@@ -0,0 +1,18 @@
+-- +goose Up
+-- rollups are fed by servers_online inserts, so retried snapshots must be deduplicated there too, see servers_info.
+-- +goose StatementBegin
+ALTER TABLE servers_online_daily MODIFY SETTING non_replicated_deduplication_window = 1000;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online_monthly MODIFY SETTING non_replicated_deduplication_window = 1000;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers_online_monthly RESET SETTING non_replicated_deduplication_window;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_online_daily RESET SETTING non_replicated_deduplication_window;
+-- +goose StatementEnd
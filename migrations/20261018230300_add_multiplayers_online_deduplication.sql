@@ -0,0 +1,10 @@
+-- +goose Up
+-- multiplayers_online sums players of the snapshot, so retried snapshots must be deduplicated, see servers_info.
+-- +goose StatementBegin
+ALTER TABLE multiplayers_online MODIFY SETTING non_replicated_deduplication_window = 1000;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE multiplayers_online RESET SETTING non_replicated_deduplication_window;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- tokenbf_v1 can't serve substring search of the name, that ngram index serves.
+-- +goose StatementBegin
+ALTER TABLE servers_info DROP INDEX name_token_idx;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers_info ADD INDEX name_token_idx lowerUTF8(name) TYPE tokenbf_v1(1024, 3, 0) GRANULARITY 4;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info MATERIALIZE INDEX name_token_idx;
+-- +goose StatementEnd
@@ -0,0 +1,59 @@
+-- +goose Up
+-- view query is modified in place, so snapshots inserted during migration are not lost.
+-- +goose StatementBegin
+ALTER TABLE servers_metrics_raw
+    ADD COLUMN map String AFTER peak_players;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    ADD COLUMN map String AFTER peak_players;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info_mv MODIFY QUERY
+SELECT multiplayer,
+       host,
+       name,
+       url,
+       gamemode,
+       language,
+       max_players,
+       peak_players,
+       map,
+       version,
+       passworded,
+       tags,
+       collected_at
+FROM servers_metrics_raw
+GROUP BY multiplayer, host, name, url, gamemode, language, max_players, peak_players, map, version, passworded, tags, collected_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE servers_info_mv MODIFY QUERY
+SELECT multiplayer,
+       host,
+       name,
+       url,
+       gamemode,
+       language,
+       max_players,
+       peak_players,
+       version,
+       passworded,
+       tags,
+       collected_at
+FROM servers_metrics_raw
+GROUP BY multiplayer, host, name, url, gamemode, language, max_players, peak_players, version, passworded, tags, collected_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    DROP COLUMN map;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_metrics_raw
+    DROP COLUMN map;
+-- +goose StatementEnd
@@ -0,0 +1,75 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE servers_online
+(
+    multiplayer   TEXT        NOT NULL,
+    host          TEXT        NOT NULL,
+    players_count INTEGER     NOT NULL,
+    collected_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (multiplayer, host, collected_at)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX servers_online_snapshot_idx ON servers_online (multiplayer, collected_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE servers_info
+(
+    multiplayer  TEXT        NOT NULL,
+    host         TEXT        NOT NULL,
+    name         TEXT        NOT NULL,
+    url          TEXT        NOT NULL,
+    gamemode     TEXT        NOT NULL,
+    language     TEXT        NOT NULL,
+    max_players  INTEGER     NOT NULL,
+    version      TEXT        NOT NULL,
+    passworded   BOOLEAN     NOT NULL,
+    tags         TEXT[]      NOT NULL,
+    collected_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (multiplayer, host)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE servers_history
+(
+    multiplayer TEXT        NOT NULL,
+    host        TEXT        NOT NULL,
+    field       TEXT        NOT NULL,
+    old_value   TEXT        NOT NULL,
+    new_value   TEXT        NOT NULL,
+    changed_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (multiplayer, host, changed_at, field)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE collection_runs
+(
+    multiplayer   TEXT        NOT NULL,
+    collected_at  TIMESTAMPTZ NOT NULL,
+    status        TEXT        NOT NULL,
+    servers_count INTEGER     NOT NULL,
+    error         TEXT        NOT NULL,
+    PRIMARY KEY (multiplayer, collected_at, status)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE collection_runs;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_history;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_info;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_online;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- passworded becomes nullable, as not every platform reports it.
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    ADD COLUMN peak_players INTEGER NOT NULL DEFAULT 0,
+    ALTER COLUMN passworded DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE servers_info SET passworded = FALSE WHERE passworded IS NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info
+    DROP COLUMN peak_players,
+    ALTER COLUMN passworded SET NOT NULL;
+-- +goose StatementEnd
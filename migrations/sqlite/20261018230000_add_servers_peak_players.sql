@@ -0,0 +1,67 @@
+-- +goose Up
+-- passworded becomes nullable, as not every platform reports it, that SQLite supports only by rebuilding the table.
+-- +goose StatementBegin
+CREATE TABLE servers_info_new
+(
+    multiplayer  TEXT    NOT NULL,
+    host         TEXT    NOT NULL,
+    name         TEXT    NOT NULL,
+    url          TEXT    NOT NULL,
+    gamemode     TEXT    NOT NULL,
+    language     TEXT    NOT NULL,
+    max_players  INTEGER NOT NULL,
+    peak_players INTEGER NOT NULL,
+    version      TEXT    NOT NULL,
+    passworded   INTEGER,
+    tags         TEXT    NOT NULL,
+    collected_at INTEGER NOT NULL,
+    PRIMARY KEY (multiplayer, host)
+) WITHOUT ROWID;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_info_new
+SELECT multiplayer, host, name, url, gamemode, language, max_players, 0, version, passworded, tags, collected_at
+FROM servers_info;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_info;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info_new RENAME TO servers_info;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE servers_info_old
+(
+    multiplayer  TEXT    NOT NULL,
+    host         TEXT    NOT NULL,
+    name         TEXT    NOT NULL,
+    url          TEXT    NOT NULL,
+    gamemode     TEXT    NOT NULL,
+    language     TEXT    NOT NULL,
+    max_players  INTEGER NOT NULL,
+    version      TEXT    NOT NULL,
+    passworded   INTEGER NOT NULL,
+    tags         TEXT    NOT NULL,
+    collected_at INTEGER NOT NULL,
+    PRIMARY KEY (multiplayer, host)
+) WITHOUT ROWID;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO servers_info_old
+SELECT multiplayer, host, name, url, gamemode, language, max_players, version, coalesce(passworded, 0), tags, collected_at
+FROM servers_info;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE servers_info;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE servers_info_old RENAME TO servers_info;
+-- +goose StatementEnd
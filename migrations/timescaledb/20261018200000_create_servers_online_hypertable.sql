@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS timescaledb;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT create_hypertable('servers_online', by_range('collected_at', INTERVAL '7 days'), migrate_data => true);
+-- +goose StatementEnd
+
+-- +goose Down
+-- hypertable can not be converted back to a plain table.
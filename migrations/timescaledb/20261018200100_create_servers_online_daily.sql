@@ -0,0 +1,33 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE MATERIALIZED VIEW servers_online_daily
+    WITH (timescaledb.continuous) AS
+SELECT multiplayer,
+       host,
+       time_bucket(INTERVAL '1 day', collected_at) AS collected_at,
+       avg(players_count)                          AS players_avg,
+       min(players_count)                          AS players_min,
+       max(players_count)                          AS players_max,
+       count(*)                                    AS samples
+FROM servers_online
+GROUP BY multiplayer, host, time_bucket(INTERVAL '1 day', collected_at)
+WITH NO DATA;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+SELECT add_continuous_aggregate_policy('servers_online_daily',
+    start_offset => INTERVAL '3 days',
+    end_offset => INTERVAL '1 hour',
+    schedule_interval => INTERVAL '1 hour');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CALL refresh_continuous_aggregate('servers_online_daily', NULL, NULL);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP MATERIALIZED VIEW servers_online_daily;
+-- +goose StatementEnd
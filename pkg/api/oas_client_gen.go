@@ -33,12 +33,30 @@ type Invoker interface {
 	//
 	// GET /multiplayer/{multiplayerName}/server/{serverHost}
 	GetServer(ctx context.Context, params GetServerParams) (GetServerRes, error)
+	// GetServerUptime invokes getServerUptime operation.
+	//
+	// Get server availability by successful collection runs.
+	//
+	// GET /multiplayer/{multiplayerName}/server/{serverHost}/uptime
+	GetServerUptime(ctx context.Context, params GetServerUptimeParams) (GetServerUptimeRes, error)
+	// ListMultiplayerStatistics invokes listMultiplayerStatistics operation.
+	//
+	// Total players and online servers count of the multiplayer, averaged over snapshots in the bucket.
+	//
+	// GET /multiplayer/{multiplayerName}/statistics
+	ListMultiplayerStatistics(ctx context.Context, params ListMultiplayerStatisticsParams) (ListMultiplayerStatisticsRes, error)
 	// ListMultiplayerSummaries invokes listMultiplayerSummaries operation.
 	//
 	// Get a summary of multiplayer platforms.
 	//
 	// GET /multiplayers/summaries
 	ListMultiplayerSummaries(ctx context.Context, params ListMultiplayerSummariesParams) ([]MultiplayerSummary, error)
+	// ListServerHistory invokes listServerHistory operation.
+	//
+	// List server metadata changes, the newest first.
+	//
+	// GET /multiplayer/{multiplayerName}/server/{serverHost}/history
+	ListServerHistory(ctx context.Context, params ListServerHistoryParams) (ListServerHistoryRes, error)
 	// ListServerStatistics invokes listServerStatistics operation.
 	//
 	// Get server statistics by host.
@@ -51,6 +69,27 @@ type Invoker interface {
 	//
 	// GET /multiplayer/{multiplayerName}/servers
 	ListServerSummaries(ctx context.Context, params ListServerSummariesParams) (ListServerSummariesRes, error)
+	// ListServerSummariesPage invokes listServerSummariesPage operation.
+	//
+	// Version 2 of listServerSummaries, that returns servers ordered by players count and host in an
+	// envelope.
+	// Pages are keyset paginated, so they do not shift between collections.
+	//
+	// GET /v2/multiplayer/{multiplayerName}/servers
+	ListServerSummariesPage(ctx context.Context, params ListServerSummariesPageParams) (ListServerSummariesPageRes, error)
+	// ListTrendingServers invokes listTrendingServers operation.
+	//
+	// Servers ranked by change of average players in the window compared with the previous window of the
+	// same length.
+	//
+	// GET /multiplayer/{multiplayerName}/trending
+	ListTrendingServers(ctx context.Context, params ListTrendingServersParams) (ListTrendingServersRes, error)
+	// SearchServers invokes searchServers operation.
+	//
+	// Servers are ordered by current players count, facets are counted by all found servers.
+	//
+	// GET /multiplayer/{multiplayerName}/search
+	SearchServers(ctx context.Context, params SearchServersParams) (SearchServersRes, error)
 }
 
 // Client implements OAS client.
@@ -206,21 +245,21 @@ func (c *Client) sendGetServer(ctx context.Context, params GetServerParams) (res
 	return result, nil
 }
 
-// ListMultiplayerSummaries invokes listMultiplayerSummaries operation.
+// GetServerUptime invokes getServerUptime operation.
 //
-// Get a summary of multiplayer platforms.
+// Get server availability by successful collection runs.
 //
-// GET /multiplayers/summaries
-func (c *Client) ListMultiplayerSummaries(ctx context.Context, params ListMultiplayerSummariesParams) ([]MultiplayerSummary, error) {
-	res, err := c.sendListMultiplayerSummaries(ctx, params)
+// GET /multiplayer/{multiplayerName}/server/{serverHost}/uptime
+func (c *Client) GetServerUptime(ctx context.Context, params GetServerUptimeParams) (GetServerUptimeRes, error) {
+	res, err := c.sendGetServerUptime(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListMultiplayerSummaries(ctx context.Context, params ListMultiplayerSummariesParams) (res []MultiplayerSummary, err error) {
+func (c *Client) sendGetServerUptime(ctx context.Context, params GetServerUptimeParams) (res GetServerUptimeRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listMultiplayerSummaries"),
+		otelogen.OperationID("getServerUptime"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/multiplayers/summaries"),
+		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/server/{serverHost}/uptime"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -236,7 +275,7 @@ func (c *Client) sendListMultiplayerSummaries(ctx context.Context, params ListMu
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListMultiplayerSummariesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetServerUptimeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -253,30 +292,47 @@ func (c *Client) sendListMultiplayerSummaries(ctx context.Context, params ListMu
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/multiplayers/summaries"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [5]string
+	pathParts[0] = "/multiplayer/"
 	{
-		// Encode "playersOrderAsc" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "playersOrderAsc",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "multiplayerName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "multiplayerName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.MultiplayerName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.PlayersOrderAsc.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/server/"
+	{
+		// Encode "serverHost" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "serverHost",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ServerHost))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/uptime"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -292,7 +348,7 @@ func (c *Client) sendListMultiplayerSummaries(ctx context.Context, params ListMu
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListMultiplayerSummariesResponse(resp)
+	result, err := decodeGetServerUptimeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -300,21 +356,21 @@ func (c *Client) sendListMultiplayerSummaries(ctx context.Context, params ListMu
 	return result, nil
 }
 
-// ListServerStatistics invokes listServerStatistics operation.
+// ListMultiplayerStatistics invokes listMultiplayerStatistics operation.
 //
-// Get server statistics by host.
+// Total players and online servers count of the multiplayer, averaged over snapshots in the bucket.
 //
-// GET /multiplayer/{multiplayerName}/server/{serverHost}/statistics
-func (c *Client) ListServerStatistics(ctx context.Context, params ListServerStatisticsParams) (ListServerStatisticsRes, error) {
-	res, err := c.sendListServerStatistics(ctx, params)
+// GET /multiplayer/{multiplayerName}/statistics
+func (c *Client) ListMultiplayerStatistics(ctx context.Context, params ListMultiplayerStatisticsParams) (ListMultiplayerStatisticsRes, error) {
+	res, err := c.sendListMultiplayerStatistics(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListServerStatistics(ctx context.Context, params ListServerStatisticsParams) (res ListServerStatisticsRes, err error) {
+func (c *Client) sendListMultiplayerStatistics(ctx context.Context, params ListMultiplayerStatisticsParams) (res ListMultiplayerStatisticsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listServerStatistics"),
+		otelogen.OperationID("listMultiplayerStatistics"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/server/{serverHost}/statistics"),
+		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/statistics"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -330,7 +386,7 @@ func (c *Client) sendListServerStatistics(ctx context.Context, params ListServer
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListServerStatisticsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListMultiplayerStatisticsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -347,7 +403,7 @@ func (c *Client) sendListServerStatistics(ctx context.Context, params ListServer
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [5]string
+	var pathParts [3]string
 	pathParts[0] = "/multiplayer/"
 	{
 		// Encode "multiplayerName" parameter.
@@ -367,26 +423,7 @@ func (c *Client) sendListServerStatistics(ctx context.Context, params ListServer
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/server/"
-	{
-		// Encode "serverHost" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "serverHost",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ServerHost))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/statistics"
+	pathParts[2] = "/statistics"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
@@ -452,7 +489,7 @@ func (c *Client) sendListServerStatistics(ctx context.Context, params ListServer
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListServerStatisticsResponse(resp)
+	result, err := decodeListMultiplayerStatisticsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -460,21 +497,21 @@ func (c *Client) sendListServerStatistics(ctx context.Context, params ListServer
 	return result, nil
 }
 
-// ListServerSummaries invokes listServerSummaries operation.
+// ListMultiplayerSummaries invokes listMultiplayerSummaries operation.
 //
-// List servers for a multiplayer platform.
+// Get a summary of multiplayer platforms.
 //
-// GET /multiplayer/{multiplayerName}/servers
-func (c *Client) ListServerSummaries(ctx context.Context, params ListServerSummariesParams) (ListServerSummariesRes, error) {
-	res, err := c.sendListServerSummaries(ctx, params)
+// GET /multiplayers/summaries
+func (c *Client) ListMultiplayerSummaries(ctx context.Context, params ListMultiplayerSummariesParams) ([]MultiplayerSummary, error) {
+	res, err := c.sendListMultiplayerSummaries(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListServerSummaries(ctx context.Context, params ListServerSummariesParams) (res ListServerSummariesRes, err error) {
+func (c *Client) sendListMultiplayerSummaries(ctx context.Context, params ListMultiplayerSummariesParams) (res []MultiplayerSummary, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listServerSummaries"),
+		otelogen.OperationID("listMultiplayerSummaries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/servers"),
+		semconv.URLTemplateKey.String("/multiplayers/summaries"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -490,7 +527,7 @@ func (c *Client) sendListServerSummaries(ctx context.Context, params ListServerS
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListServerSummariesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListMultiplayerSummariesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -507,7 +544,101 @@ func (c *Client) sendListServerSummaries(ctx context.Context, params ListServerS
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [1]string
+	pathParts[0] = "/multiplayers/summaries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "playersOrderAsc" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "playersOrderAsc",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PlayersOrderAsc.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListMultiplayerSummariesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListServerHistory invokes listServerHistory operation.
+//
+// List server metadata changes, the newest first.
+//
+// GET /multiplayer/{multiplayerName}/server/{serverHost}/history
+func (c *Client) ListServerHistory(ctx context.Context, params ListServerHistoryParams) (ListServerHistoryRes, error) {
+	res, err := c.sendListServerHistory(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListServerHistory(ctx context.Context, params ListServerHistoryParams) (res ListServerHistoryRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listServerHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/server/{serverHost}/history"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListServerHistoryOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
 	pathParts[0] = "/multiplayer/"
 	{
 		// Encode "multiplayerName" parameter.
@@ -527,28 +658,30 @@ func (c *Client) sendListServerSummaries(ctx context.Context, params ListServerS
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/servers"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	pathParts[2] = "/server/"
 	{
-		// Encode "playersOrderAsc" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "playersOrderAsc",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "serverHost" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "serverHost",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ServerHost))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.PlayersOrderAsc.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[3] = encoded
 	}
+	pathParts[4] = "/history"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
 		// Encode "limit" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -583,16 +716,202 @@ func (c *Client) sendListServerSummaries(ctx context.Context, params ListServerS
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "includeOffline" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "includeOffline",
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListServerHistoryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListServerStatistics invokes listServerStatistics operation.
+//
+// Get server statistics by host.
+//
+// GET /multiplayer/{multiplayerName}/server/{serverHost}/statistics
+func (c *Client) ListServerStatistics(ctx context.Context, params ListServerStatisticsParams) (ListServerStatisticsRes, error) {
+	res, err := c.sendListServerStatistics(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListServerStatistics(ctx context.Context, params ListServerStatisticsParams) (res ListServerStatisticsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listServerStatistics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/server/{serverHost}/statistics"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListServerStatisticsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/multiplayer/"
+	{
+		// Encode "multiplayerName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "multiplayerName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.MultiplayerName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/server/"
+	{
+		// Encode "serverHost" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "serverHost",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ServerHost))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/statistics"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.IncludeOffline.Get(); ok {
+			return e.EncodeValue(conv.DateTimeToString(params.From))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.DateTimeToString(params.To))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "precision" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "precision",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Precision.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "aggregates" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "aggregates",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Aggregates != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Aggregates {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(string(item)))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fill" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fill",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fill.Get(); ok {
 				return e.EncodeValue(conv.BoolToString(val))
 			}
 			return nil
@@ -616,7 +935,734 @@ func (c *Client) sendListServerSummaries(ctx context.Context, params ListServerS
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListServerSummariesResponse(resp)
+	result, err := decodeListServerStatisticsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListServerSummaries invokes listServerSummaries operation.
+//
+// List servers for a multiplayer platform.
+//
+// GET /multiplayer/{multiplayerName}/servers
+func (c *Client) ListServerSummaries(ctx context.Context, params ListServerSummariesParams) (ListServerSummariesRes, error) {
+	res, err := c.sendListServerSummaries(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListServerSummaries(ctx context.Context, params ListServerSummariesParams) (res ListServerSummariesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listServerSummaries"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/servers"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListServerSummariesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/multiplayer/"
+	{
+		// Encode "multiplayerName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "multiplayerName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.MultiplayerName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/servers"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "playersOrderAsc" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "playersOrderAsc",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PlayersOrderAsc.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "includeOffline" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "includeOffline",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeOffline.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListServerSummariesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListServerSummariesPage invokes listServerSummariesPage operation.
+//
+// Version 2 of listServerSummaries, that returns servers ordered by players count and host in an
+// envelope.
+// Pages are keyset paginated, so they do not shift between collections.
+//
+// GET /v2/multiplayer/{multiplayerName}/servers
+func (c *Client) ListServerSummariesPage(ctx context.Context, params ListServerSummariesPageParams) (ListServerSummariesPageRes, error) {
+	res, err := c.sendListServerSummariesPage(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListServerSummariesPage(ctx context.Context, params ListServerSummariesPageParams) (res ListServerSummariesPageRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listServerSummariesPage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/v2/multiplayer/{multiplayerName}/servers"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListServerSummariesPageOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v2/multiplayer/"
+	{
+		// Encode "multiplayerName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "multiplayerName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.MultiplayerName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/servers"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "playersOrderAsc" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "playersOrderAsc",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PlayersOrderAsc.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "includeOffline" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "includeOffline",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeOffline.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListServerSummariesPageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTrendingServers invokes listTrendingServers operation.
+//
+// Servers ranked by change of average players in the window compared with the previous window of the
+// same length.
+//
+// GET /multiplayer/{multiplayerName}/trending
+func (c *Client) ListTrendingServers(ctx context.Context, params ListTrendingServersParams) (ListTrendingServersRes, error) {
+	res, err := c.sendListTrendingServers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTrendingServers(ctx context.Context, params ListTrendingServersParams) (res ListTrendingServersRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTrendingServers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/trending"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTrendingServersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/multiplayer/"
+	{
+		// Encode "multiplayerName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "multiplayerName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.MultiplayerName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/trending"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "window" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "window",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Window.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "orderBy" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "orderBy",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OrderBy.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTrendingServersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SearchServers invokes searchServers operation.
+//
+// Servers are ordered by current players count, facets are counted by all found servers.
+//
+// GET /multiplayer/{multiplayerName}/search
+func (c *Client) SearchServers(ctx context.Context, params SearchServersParams) (SearchServersRes, error) {
+	res, err := c.sendSearchServers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSearchServers(ctx context.Context, params SearchServersParams) (res SearchServersRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("searchServers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/multiplayer/{multiplayerName}/search"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchServersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/multiplayer/"
+	{
+		// Encode "multiplayerName" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "multiplayerName",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.MultiplayerName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Q.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "language" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Language.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "gamemode" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "gamemode",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Gamemode.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "minPlayers" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "minPlayers",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MinPlayers.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "maxPlayers" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "maxPlayers",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MaxPlayers.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSearchServersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
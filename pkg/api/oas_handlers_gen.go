@@ -174,22 +174,779 @@ func (s *Server) handleGetServerRequest(args [2]string, argsEscaped bool, w http
 	}
 }
 
+// handleGetServerUptimeRequest handles getServerUptime operation.
+//
+// Get server availability by successful collection runs.
+//
+// GET /multiplayer/{multiplayerName}/server/{serverHost}/uptime
+func (s *Server) handleGetServerUptimeRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getServerUptime"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/server/{serverHost}/uptime"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetServerUptimeOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetServerUptimeOperation,
+			ID:   "getServerUptime",
+		}
+	)
+	params, err := decodeGetServerUptimeParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetServerUptimeRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetServerUptimeOperation,
+			OperationSummary: "Get server availability by successful collection runs",
+			OperationID:      "getServerUptime",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "multiplayerName",
+					In:   "path",
+				}: params.MultiplayerName,
+				{
+					Name: "serverHost",
+					In:   "path",
+				}: params.ServerHost,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetServerUptimeParams
+			Response = GetServerUptimeRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetServerUptimeParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetServerUptime(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetServerUptime(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetServerUptimeResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListMultiplayerStatisticsRequest handles listMultiplayerStatistics operation.
+//
+// Total players and online servers count of the multiplayer, averaged over snapshots in the bucket.
+//
+// GET /multiplayer/{multiplayerName}/statistics
+func (s *Server) handleListMultiplayerStatisticsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listMultiplayerStatistics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/statistics"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListMultiplayerStatisticsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListMultiplayerStatisticsOperation,
+			ID:   "listMultiplayerStatistics",
+		}
+	)
+	params, err := decodeListMultiplayerStatisticsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ListMultiplayerStatisticsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListMultiplayerStatisticsOperation,
+			OperationSummary: "Get multiplayer statistics",
+			OperationID:      "listMultiplayerStatistics",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "multiplayerName",
+					In:   "path",
+				}: params.MultiplayerName,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "precision",
+					In:   "query",
+				}: params.Precision,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListMultiplayerStatisticsParams
+			Response = ListMultiplayerStatisticsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListMultiplayerStatisticsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListMultiplayerStatistics(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListMultiplayerStatistics(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListMultiplayerStatisticsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
 // handleListMultiplayerSummariesRequest handles listMultiplayerSummaries operation.
 //
-// Get a summary of multiplayer platforms.
+// Get a summary of multiplayer platforms.
+//
+// GET /multiplayers/summaries
+func (s *Server) handleListMultiplayerSummariesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listMultiplayerSummaries"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/multiplayers/summaries"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListMultiplayerSummariesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListMultiplayerSummariesOperation,
+			ID:   "listMultiplayerSummaries",
+		}
+	)
+	params, err := decodeListMultiplayerSummariesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []MultiplayerSummary
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListMultiplayerSummariesOperation,
+			OperationSummary: "Get a summary of multiplayer platforms",
+			OperationID:      "listMultiplayerSummaries",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "playersOrderAsc",
+					In:   "query",
+				}: params.PlayersOrderAsc,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListMultiplayerSummariesParams
+			Response = []MultiplayerSummary
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListMultiplayerSummariesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListMultiplayerSummaries(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListMultiplayerSummaries(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListMultiplayerSummariesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListServerHistoryRequest handles listServerHistory operation.
+//
+// List server metadata changes, the newest first.
+//
+// GET /multiplayer/{multiplayerName}/server/{serverHost}/history
+func (s *Server) handleListServerHistoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listServerHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/server/{serverHost}/history"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListServerHistoryOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListServerHistoryOperation,
+			ID:   "listServerHistory",
+		}
+	)
+	params, err := decodeListServerHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ListServerHistoryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListServerHistoryOperation,
+			OperationSummary: "List server metadata changes, the newest first",
+			OperationID:      "listServerHistory",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "multiplayerName",
+					In:   "path",
+				}: params.MultiplayerName,
+				{
+					Name: "serverHost",
+					In:   "path",
+				}: params.ServerHost,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListServerHistoryParams
+			Response = ListServerHistoryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListServerHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListServerHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListServerHistory(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListServerHistoryResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListServerStatisticsRequest handles listServerStatistics operation.
+//
+// Get server statistics by host.
+//
+// GET /multiplayer/{multiplayerName}/server/{serverHost}/statistics
+func (s *Server) handleListServerStatisticsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listServerStatistics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/server/{serverHost}/statistics"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListServerStatisticsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListServerStatisticsOperation,
+			ID:   "listServerStatistics",
+		}
+	)
+	params, err := decodeListServerStatisticsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response ListServerStatisticsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListServerStatisticsOperation,
+			OperationSummary: "Get server statistics by host",
+			OperationID:      "listServerStatistics",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "multiplayerName",
+					In:   "path",
+				}: params.MultiplayerName,
+				{
+					Name: "serverHost",
+					In:   "path",
+				}: params.ServerHost,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+				{
+					Name: "precision",
+					In:   "query",
+				}: params.Precision,
+				{
+					Name: "aggregates",
+					In:   "query",
+				}: params.Aggregates,
+				{
+					Name: "fill",
+					In:   "query",
+				}: params.Fill,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListServerStatisticsParams
+			Response = ListServerStatisticsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListServerStatisticsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListServerStatistics(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListServerStatistics(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListServerStatisticsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListServerSummariesRequest handles listServerSummaries operation.
+//
+// List servers for a multiplayer platform.
 //
-// GET /multiplayers/summaries
-func (s *Server) handleListMultiplayerSummariesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /multiplayer/{multiplayerName}/servers
+func (s *Server) handleListServerSummariesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listMultiplayerSummaries"),
+		otelogen.OperationID("listServerSummaries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/multiplayers/summaries"),
+		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/servers"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListMultiplayerSummariesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListServerSummariesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -244,11 +1001,11 @@ func (s *Server) handleListMultiplayerSummariesRequest(args [0]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListMultiplayerSummariesOperation,
-			ID:   "listMultiplayerSummaries",
+			Name: ListServerSummariesOperation,
+			ID:   "listServerSummaries",
 		}
 	)
-	params, err := decodeListMultiplayerSummariesParams(args, argsEscaped, r)
+	params, err := decodeListServerSummariesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -261,28 +1018,44 @@ func (s *Server) handleListMultiplayerSummariesRequest(args [0]string, argsEscap
 
 	var rawBody []byte
 
-	var response []MultiplayerSummary
+	var response ListServerSummariesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListMultiplayerSummariesOperation,
-			OperationSummary: "Get a summary of multiplayer platforms",
-			OperationID:      "listMultiplayerSummaries",
+			OperationName:    ListServerSummariesOperation,
+			OperationSummary: "List servers for a multiplayer platform",
+			OperationID:      "listServerSummaries",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
+				{
+					Name: "multiplayerName",
+					In:   "path",
+				}: params.MultiplayerName,
 				{
 					Name: "playersOrderAsc",
 					In:   "query",
 				}: params.PlayersOrderAsc,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "includeOffline",
+					In:   "query",
+				}: params.IncludeOffline,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListMultiplayerSummariesParams
-			Response = []MultiplayerSummary
+			Params   = ListServerSummariesParams
+			Response = ListServerSummariesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -291,14 +1064,14 @@ func (s *Server) handleListMultiplayerSummariesRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackListMultiplayerSummariesParams,
+			unpackListServerSummariesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListMultiplayerSummaries(ctx, params)
+				response, err = s.h.ListServerSummaries(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListMultiplayerSummaries(ctx, params)
+		response, err = s.h.ListServerSummaries(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -306,7 +1079,7 @@ func (s *Server) handleListMultiplayerSummariesRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeListMultiplayerSummariesResponse(response, w, span); err != nil {
+	if err := encodeListServerSummariesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -315,22 +1088,24 @@ func (s *Server) handleListMultiplayerSummariesRequest(args [0]string, argsEscap
 	}
 }
 
-// handleListServerStatisticsRequest handles listServerStatistics operation.
+// handleListServerSummariesPageRequest handles listServerSummariesPage operation.
 //
-// Get server statistics by host.
+// Version 2 of listServerSummaries, that returns servers ordered by players count and host in an
+// envelope.
+// Pages are keyset paginated, so they do not shift between collections.
 //
-// GET /multiplayer/{multiplayerName}/server/{serverHost}/statistics
-func (s *Server) handleListServerStatisticsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v2/multiplayer/{multiplayerName}/servers
+func (s *Server) handleListServerSummariesPageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listServerStatistics"),
+		otelogen.OperationID("listServerSummariesPage"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/server/{serverHost}/statistics"),
+		semconv.HTTPRouteKey.String("/v2/multiplayer/{multiplayerName}/servers"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListServerStatisticsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListServerSummariesPageOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -385,11 +1160,11 @@ func (s *Server) handleListServerStatisticsRequest(args [2]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListServerStatisticsOperation,
-			ID:   "listServerStatistics",
+			Name: ListServerSummariesPageOperation,
+			ID:   "listServerSummariesPage",
 		}
 	)
-	params, err := decodeListServerStatisticsParams(args, argsEscaped, r)
+	params, err := decodeListServerSummariesPageParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -402,13 +1177,13 @@ func (s *Server) handleListServerStatisticsRequest(args [2]string, argsEscaped b
 
 	var rawBody []byte
 
-	var response ListServerStatisticsRes
+	var response ListServerSummariesPageRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListServerStatisticsOperation,
-			OperationSummary: "Get server statistics by host",
-			OperationID:      "listServerStatistics",
+			OperationName:    ListServerSummariesPageOperation,
+			OperationSummary: "List servers for a multiplayer platform with cursor pagination",
+			OperationID:      "listServerSummariesPage",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -417,29 +1192,29 @@ func (s *Server) handleListServerStatisticsRequest(args [2]string, argsEscaped b
 					In:   "path",
 				}: params.MultiplayerName,
 				{
-					Name: "serverHost",
-					In:   "path",
-				}: params.ServerHost,
+					Name: "playersOrderAsc",
+					In:   "query",
+				}: params.PlayersOrderAsc,
 				{
-					Name: "from",
+					Name: "limit",
 					In:   "query",
-				}: params.From,
+				}: params.Limit,
 				{
-					Name: "to",
+					Name: "cursor",
 					In:   "query",
-				}: params.To,
+				}: params.Cursor,
 				{
-					Name: "precision",
+					Name: "includeOffline",
 					In:   "query",
-				}: params.Precision,
+				}: params.IncludeOffline,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListServerStatisticsParams
-			Response = ListServerStatisticsRes
+			Params   = ListServerSummariesPageParams
+			Response = ListServerSummariesPageRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -448,14 +1223,14 @@ func (s *Server) handleListServerStatisticsRequest(args [2]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackListServerStatisticsParams,
+			unpackListServerSummariesPageParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListServerStatistics(ctx, params)
+				response, err = s.h.ListServerSummariesPage(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListServerStatistics(ctx, params)
+		response, err = s.h.ListServerSummariesPage(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -463,7 +1238,7 @@ func (s *Server) handleListServerStatisticsRequest(args [2]string, argsEscaped b
 		return
 	}
 
-	if err := encodeListServerStatisticsResponse(response, w, span); err != nil {
+	if err := encodeListServerSummariesPageResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -472,22 +1247,23 @@ func (s *Server) handleListServerStatisticsRequest(args [2]string, argsEscaped b
 	}
 }
 
-// handleListServerSummariesRequest handles listServerSummaries operation.
+// handleListTrendingServersRequest handles listTrendingServers operation.
 //
-// List servers for a multiplayer platform.
+// Servers ranked by change of average players in the window compared with the previous window of the
+// same length.
 //
-// GET /multiplayer/{multiplayerName}/servers
-func (s *Server) handleListServerSummariesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /multiplayer/{multiplayerName}/trending
+func (s *Server) handleListTrendingServersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listServerSummaries"),
+		otelogen.OperationID("listTrendingServers"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/servers"),
+		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/trending"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListServerSummariesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTrendingServersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -542,11 +1318,11 @@ func (s *Server) handleListServerSummariesRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListServerSummariesOperation,
-			ID:   "listServerSummaries",
+			Name: ListTrendingServersOperation,
+			ID:   "listTrendingServers",
 		}
 	)
-	params, err := decodeListServerSummariesParams(args, argsEscaped, r)
+	params, err := decodeListTrendingServersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -559,13 +1335,13 @@ func (s *Server) handleListServerSummariesRequest(args [1]string, argsEscaped bo
 
 	var rawBody []byte
 
-	var response ListServerSummariesRes
+	var response ListTrendingServersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListServerSummariesOperation,
-			OperationSummary: "List servers for a multiplayer platform",
-			OperationID:      "listServerSummaries",
+			OperationName:    ListTrendingServersOperation,
+			OperationSummary: "List trending servers for a multiplayer platform",
+			OperationID:      "listTrendingServers",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -574,9 +1350,13 @@ func (s *Server) handleListServerSummariesRequest(args [1]string, argsEscaped bo
 					In:   "path",
 				}: params.MultiplayerName,
 				{
-					Name: "playersOrderAsc",
+					Name: "window",
 					In:   "query",
-				}: params.PlayersOrderAsc,
+				}: params.Window,
+				{
+					Name: "orderBy",
+					In:   "query",
+				}: params.OrderBy,
 				{
 					Name: "limit",
 					In:   "query",
@@ -585,18 +1365,187 @@ func (s *Server) handleListServerSummariesRequest(args [1]string, argsEscaped bo
 					Name: "offset",
 					In:   "query",
 				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListTrendingServersParams
+			Response = ListTrendingServersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListTrendingServersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTrendingServers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTrendingServers(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListTrendingServersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSearchServersRequest handles searchServers operation.
+//
+// Servers are ordered by current players count, facets are counted by all found servers.
+//
+// GET /multiplayer/{multiplayerName}/search
+func (s *Server) handleSearchServersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("searchServers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/multiplayer/{multiplayerName}/search"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SearchServersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SearchServersOperation,
+			ID:   "searchServers",
+		}
+	)
+	params, err := decodeSearchServersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response SearchServersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SearchServersOperation,
+			OperationSummary: "Search servers of a multiplayer platform",
+			OperationID:      "searchServers",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
 				{
-					Name: "includeOffline",
+					Name: "multiplayerName",
+					In:   "path",
+				}: params.MultiplayerName,
+				{
+					Name: "q",
 					In:   "query",
-				}: params.IncludeOffline,
+				}: params.Q,
+				{
+					Name: "language",
+					In:   "query",
+				}: params.Language,
+				{
+					Name: "gamemode",
+					In:   "query",
+				}: params.Gamemode,
+				{
+					Name: "minPlayers",
+					In:   "query",
+				}: params.MinPlayers,
+				{
+					Name: "maxPlayers",
+					In:   "query",
+				}: params.MaxPlayers,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListServerSummariesParams
-			Response = ListServerSummariesRes
+			Params   = SearchServersParams
+			Response = SearchServersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -605,14 +1554,14 @@ func (s *Server) handleListServerSummariesRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackListServerSummariesParams,
+			unpackSearchServersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListServerSummaries(ctx, params)
+				response, err = s.h.SearchServers(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListServerSummaries(ctx, params)
+		response, err = s.h.SearchServers(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -620,7 +1569,7 @@ func (s *Server) handleListServerSummariesRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeListServerSummariesResponse(response, w, span); err != nil {
+	if err := encodeSearchServersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
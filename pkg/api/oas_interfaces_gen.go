@@ -5,10 +5,34 @@ type GetServerRes interface {
 	getServerRes()
 }
 
+type GetServerUptimeRes interface {
+	getServerUptimeRes()
+}
+
+type ListMultiplayerStatisticsRes interface {
+	listMultiplayerStatisticsRes()
+}
+
+type ListServerHistoryRes interface {
+	listServerHistoryRes()
+}
+
 type ListServerStatisticsRes interface {
 	listServerStatisticsRes()
 }
 
+type ListServerSummariesPageRes interface {
+	listServerSummariesPageRes()
+}
+
 type ListServerSummariesRes interface {
 	listServerSummariesRes()
 }
+
+type ListTrendingServersRes interface {
+	listTrendingServersRes()
+}
+
+type SearchServersRes interface {
+	searchServersRes()
+}
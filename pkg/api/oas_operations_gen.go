@@ -6,8 +6,14 @@ package api
 type OperationName = string
 
 const (
-	GetServerOperation                OperationName = "GetServer"
-	ListMultiplayerSummariesOperation OperationName = "ListMultiplayerSummaries"
-	ListServerStatisticsOperation     OperationName = "ListServerStatistics"
-	ListServerSummariesOperation      OperationName = "ListServerSummaries"
+	GetServerOperation                 OperationName = "GetServer"
+	GetServerUptimeOperation           OperationName = "GetServerUptime"
+	ListMultiplayerStatisticsOperation OperationName = "ListMultiplayerStatistics"
+	ListMultiplayerSummariesOperation  OperationName = "ListMultiplayerSummaries"
+	ListServerHistoryOperation         OperationName = "ListServerHistory"
+	ListServerStatisticsOperation      OperationName = "ListServerStatistics"
+	ListServerSummariesOperation       OperationName = "ListServerSummaries"
+	ListServerSummariesPageOperation   OperationName = "ListServerSummariesPage"
+	ListTrendingServersOperation       OperationName = "ListTrendingServers"
+	SearchServersOperation             OperationName = "SearchServers"
 )
@@ -3,6 +3,7 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"time"
@@ -135,86 +136,141 @@ func decodeGetServerParams(args [2]string, argsEscaped bool, r *http.Request) (p
 	return params, nil
 }
 
-// ListMultiplayerSummariesParams is parameters of listMultiplayerSummaries operation.
-type ListMultiplayerSummariesParams struct {
-	// Sort order by current players count.
-	PlayersOrderAsc OptBool `json:",omitempty,omitzero"`
+// GetServerUptimeParams is parameters of getServerUptime operation.
+type GetServerUptimeParams struct {
+	// Multiplayer platform name.
+	MultiplayerName string
+	// Server host.
+	ServerHost string
 }
 
-func unpackListMultiplayerSummariesParams(packed middleware.Parameters) (params ListMultiplayerSummariesParams) {
+func unpackGetServerUptimeParams(packed middleware.Parameters) (params GetServerUptimeParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "playersOrderAsc",
-			In:   "query",
+			Name: "multiplayerName",
+			In:   "path",
 		}
-		if v, ok := packed[key]; ok {
-			params.PlayersOrderAsc = v.(OptBool)
+		params.MultiplayerName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "serverHost",
+			In:   "path",
 		}
+		params.ServerHost = packed[key].(string)
 	}
 	return params
 }
 
-func decodeListMultiplayerSummariesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMultiplayerSummariesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: playersOrderAsc.
+func decodeGetServerUptimeParams(args [2]string, argsEscaped bool, r *http.Request) (params GetServerUptimeParams, _ error) {
+	// Decode path: multiplayerName.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "playersOrderAsc",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "multiplayerName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPlayersOrderAscVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
 
-					paramsDotPlayersOrderAscVal = c
-					return nil
-				}(); err != nil {
+				params.MultiplayerName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "multiplayerName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: serverHost.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "serverHost",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.PlayersOrderAsc.SetTo(paramsDotPlayersOrderAscVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ServerHost = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "playersOrderAsc",
-			In:   "query",
+			Name: "serverHost",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// ListServerStatisticsParams is parameters of listServerStatistics operation.
-type ListServerStatisticsParams struct {
+// ListMultiplayerStatisticsParams is parameters of listMultiplayerStatistics operation.
+type ListMultiplayerStatisticsParams struct {
 	// Multiplayer platform name.
 	MultiplayerName string
-	// Server host.
-	ServerHost string
 	// Start of the time range.
 	From time.Time
 	// End of the time range.
 	To time.Time
-	// Output precision.
-	Precision OptListServerStatisticsPrecision `json:",omitempty,omitzero"`
+	// Output precision, sub-hour precisions are useful only for sources collected more often than hourly.
+	// Time range is limited to 30 days for hourly and sub-hour precisions, to 2 years for perDay and
+	// perWeek and to 10 years for perMonth.
+	Precision OptStatisticsPrecision `json:",omitempty,omitzero"`
 }
 
-func unpackListServerStatisticsParams(packed middleware.Parameters) (params ListServerStatisticsParams) {
+func unpackListMultiplayerStatisticsParams(packed middleware.Parameters) (params ListMultiplayerStatisticsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "multiplayerName",
@@ -222,13 +278,6 @@ func unpackListServerStatisticsParams(packed middleware.Parameters) (params List
 		}
 		params.MultiplayerName = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "serverHost",
-			In:   "path",
-		}
-		params.ServerHost = packed[key].(string)
-	}
 	{
 		key := middleware.ParameterKey{
 			Name: "from",
@@ -249,13 +298,13 @@ func unpackListServerStatisticsParams(packed middleware.Parameters) (params List
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Precision = v.(OptListServerStatisticsPrecision)
+			params.Precision = v.(OptStatisticsPrecision)
 		}
 	}
 	return params
 }
 
-func decodeListServerStatisticsParams(args [2]string, argsEscaped bool, r *http.Request) (params ListServerStatisticsParams, _ error) {
+func decodeListMultiplayerStatisticsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListMultiplayerStatisticsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: multiplayerName.
 	if err := func() error {
@@ -302,51 +351,6 @@ func decodeListServerStatisticsParams(args [2]string, argsEscaped bool, r *http.
 			Err:  err,
 		}
 	}
-	// Decode path: serverHost.
-	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "serverHost",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.ServerHost = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "serverHost",
-			In:   "path",
-			Err:  err,
-		}
-	}
 	// Decode query: from.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -421,7 +425,7 @@ func decodeListServerStatisticsParams(args [2]string, argsEscaped bool, r *http.
 	}
 	// Set default value for query: precision.
 	{
-		val := ListServerStatisticsPrecision("perHour")
+		val := StatisticsPrecision("perHour")
 		params.Precision.SetTo(val)
 	}
 	// Decode query: precision.
@@ -434,7 +438,7 @@ func decodeListServerStatisticsParams(args [2]string, argsEscaped bool, r *http.
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPrecisionVal ListServerStatisticsPrecision
+				var paramsDotPrecisionVal StatisticsPrecision
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -446,7 +450,7 @@ func decodeListServerStatisticsParams(args [2]string, argsEscaped bool, r *http.
 						return err
 					}
 
-					paramsDotPrecisionVal = ListServerStatisticsPrecision(c)
+					paramsDotPrecisionVal = StatisticsPrecision(c)
 					return nil
 				}(); err != nil {
 					return err
@@ -483,28 +487,13 @@ func decodeListServerStatisticsParams(args [2]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// ListServerSummariesParams is parameters of listServerSummaries operation.
-type ListServerSummariesParams struct {
-	// Multiplayer platform name.
-	MultiplayerName string
-	// Sort order by players count.
+// ListMultiplayerSummariesParams is parameters of listMultiplayerSummaries operation.
+type ListMultiplayerSummariesParams struct {
+	// Sort order by current players count.
 	PlayersOrderAsc OptBool `json:",omitempty,omitzero"`
-	// Maximum number of items to return in the response. Used for pagination.
-	Limit OptInt32 `json:",omitempty,omitzero"`
-	// Number of servers to skip before starting to collect the result set. Used for pagination.
-	Offset OptInt32 `json:",omitempty,omitzero"`
-	// Whether to include offline servers.
-	IncludeOffline OptBool `json:",omitempty,omitzero"`
 }
 
-func unpackListServerSummariesParams(packed middleware.Parameters) (params ListServerSummariesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "multiplayerName",
-			In:   "path",
-		}
-		params.MultiplayerName = packed[key].(string)
-	}
+func unpackListMultiplayerSummariesParams(packed middleware.Parameters) (params ListMultiplayerSummariesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "playersOrderAsc",
@@ -514,111 +503,2023 @@ func unpackListServerSummariesParams(packed middleware.Parameters) (params ListS
 			params.PlayersOrderAsc = v.(OptBool)
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "includeOffline",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IncludeOffline = v.(OptBool)
-		}
-	}
 	return params
 }
 
-func decodeListServerSummariesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListServerSummariesParams, _ error) {
+func decodeListMultiplayerSummariesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMultiplayerSummariesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: multiplayerName.
+	// Decode query: playersOrderAsc.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "playersOrderAsc",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "multiplayerName",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPlayersOrderAscVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPlayersOrderAscVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PlayersOrderAsc.SetTo(paramsDotPlayersOrderAscVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "playersOrderAsc",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListServerHistoryParams is parameters of listServerHistory operation.
+type ListServerHistoryParams struct {
+	// Multiplayer platform name.
+	MultiplayerName string
+	// Server host.
+	ServerHost string
+	// Maximum number of items to return in the response. Used for pagination.
+	Limit OptInt32 `json:",omitempty,omitzero"`
+	// Number of changes to skip before starting to collect the result set. Used for pagination.
+	Offset OptInt32 `json:",omitempty,omitzero"`
+}
+
+func unpackListServerHistoryParams(packed middleware.Parameters) (params ListServerHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "multiplayerName",
+			In:   "path",
+		}
+		params.MultiplayerName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "serverHost",
+			In:   "path",
+		}
+		params.ServerHost = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeListServerHistoryParams(args [2]string, argsEscaped bool, r *http.Request) (params ListServerHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: multiplayerName.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "multiplayerName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MultiplayerName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "multiplayerName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: serverHost.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "serverHost",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ServerHost = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "serverHost",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int32(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int32(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListServerStatisticsParams is parameters of listServerStatistics operation.
+type ListServerStatisticsParams struct {
+	// Multiplayer platform name.
+	MultiplayerName string
+	// Server host.
+	ServerHost string
+	// Start of the time range.
+	From time.Time
+	// End of the time range.
+	To time.Time
+	// Output precision, sub-hour precisions are useful only for sources collected more often than hourly.
+	// Time range is limited to 30 days for hourly and sub-hour precisions, to 2 years for perDay and
+	// perWeek and to 10 years for perMonth.
+	Precision OptStatisticsPrecision `json:",omitempty,omitzero"`
+	// Comma separated aggregates of players count, that are computed in addition to average.
+	Aggregates []ListServerStatisticsAggregatesItem `json:",omitempty"`
+	// Return a dense series across the time range, buckets without the server are returned with null
+	// players
+	// and a status, that distinguishes offline server from failed collection.
+	Fill OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackListServerStatisticsParams(packed middleware.Parameters) (params ListServerStatisticsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "multiplayerName",
+			In:   "path",
+		}
+		params.MultiplayerName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "serverHost",
+			In:   "path",
+		}
+		params.ServerHost = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		params.From = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		params.To = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "precision",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Precision = v.(OptStatisticsPrecision)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "aggregates",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Aggregates = v.([]ListServerStatisticsAggregatesItem)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "fill",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Fill = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeListServerStatisticsParams(args [2]string, argsEscaped bool, r *http.Request) (params ListServerStatisticsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: multiplayerName.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "multiplayerName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MultiplayerName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "multiplayerName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: serverHost.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "serverHost",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ServerHost = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "serverHost",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToDateTime(val)
+				if err != nil {
+					return err
+				}
+
+				params.From = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToDateTime(val)
+				if err != nil {
+					return err
+				}
+
+				params.To = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: precision.
+	{
+		val := StatisticsPrecision("perHour")
+		params.Precision.SetTo(val)
+	}
+	// Decode query: precision.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "precision",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPrecisionVal StatisticsPrecision
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPrecisionVal = StatisticsPrecision(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Precision.SetTo(paramsDotPrecisionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Precision.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "precision",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: aggregates.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "aggregates",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotAggregatesVal ListServerStatisticsAggregatesItem
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotAggregatesVal = ListServerStatisticsAggregatesItem(c)
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Aggregates = append(params.Aggregates, paramsDotAggregatesVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Aggregates {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "aggregates",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: fill.
+	{
+		val := bool(false)
+		params.Fill.SetTo(val)
+	}
+	// Decode query: fill.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "fill",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFillVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFillVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Fill.SetTo(paramsDotFillVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "fill",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListServerSummariesParams is parameters of listServerSummaries operation.
+type ListServerSummariesParams struct {
+	// Multiplayer platform name.
+	MultiplayerName string
+	// Sort order by players count.
+	PlayersOrderAsc OptBool `json:",omitempty,omitzero"`
+	// Maximum number of items to return in the response. Used for pagination.
+	Limit OptInt32 `json:",omitempty,omitzero"`
+	// Number of servers to skip before starting to collect the result set. Used for pagination.
+	Offset OptInt32 `json:",omitempty,omitzero"`
+	// Whether to include servers missing from the latest snapshot of the multiplayer.
+	IncludeOffline OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackListServerSummariesParams(packed middleware.Parameters) (params ListServerSummariesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "multiplayerName",
+			In:   "path",
+		}
+		params.MultiplayerName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "playersOrderAsc",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PlayersOrderAsc = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeOffline",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeOffline = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeListServerSummariesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListServerSummariesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: multiplayerName.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "multiplayerName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MultiplayerName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "multiplayerName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: playersOrderAsc.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "playersOrderAsc",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPlayersOrderAscVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPlayersOrderAscVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PlayersOrderAsc.SetTo(paramsDotPlayersOrderAscVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "playersOrderAsc",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int32(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int32(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeOffline.
+	{
+		val := bool(false)
+		params.IncludeOffline.SetTo(val)
+	}
+	// Decode query: includeOffline.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeOffline",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeOfflineVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeOfflineVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeOffline.SetTo(paramsDotIncludeOfflineVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeOffline",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListServerSummariesPageParams is parameters of listServerSummariesPage operation.
+type ListServerSummariesPageParams struct {
+	// Multiplayer platform name.
+	MultiplayerName string
+	// Sort order by players count.
+	PlayersOrderAsc OptBool `json:",omitempty,omitzero"`
+	// Maximum number of items to return in the response.
+	Limit OptInt32 `json:",omitempty,omitzero"`
+	// Opaque cursor of the next page, that is returned as nextCursor of the previous one.
+	// Cursor is valid only with the same playersOrderAsc and includeOffline as the previous page.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// Whether to include servers missing from the latest snapshot of the multiplayer.
+	IncludeOffline OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackListServerSummariesPageParams(packed middleware.Parameters) (params ListServerSummariesPageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "multiplayerName",
+			In:   "path",
+		}
+		params.MultiplayerName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "playersOrderAsc",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PlayersOrderAsc = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeOffline",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeOffline = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeListServerSummariesPageParams(args [1]string, argsEscaped bool, r *http.Request) (params ListServerSummariesPageParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: multiplayerName.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "multiplayerName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MultiplayerName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "multiplayerName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: playersOrderAsc.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "playersOrderAsc",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPlayersOrderAscVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPlayersOrderAscVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PlayersOrderAsc.SetTo(paramsDotPlayersOrderAscVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "playersOrderAsc",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int32(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeOffline.
+	{
+		val := bool(false)
+		params.IncludeOffline.SetTo(val)
+	}
+	// Decode query: includeOffline.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeOffline",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeOfflineVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeOfflineVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeOffline.SetTo(paramsDotIncludeOfflineVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeOffline",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTrendingServersParams is parameters of listTrendingServers operation.
+type ListTrendingServersParams struct {
+	// Multiplayer platform name.
+	MultiplayerName string
+	// Window ending now, that is compared with the previous one.
+	Window OptListTrendingServersWindow `json:",omitempty,omitzero"`
+	// Rank by absolute or relative change of average players.
+	OrderBy OptListTrendingServersOrderBy `json:",omitempty,omitzero"`
+	// Maximum number of items to return in the response. Used for pagination.
+	Limit OptInt32 `json:",omitempty,omitzero"`
+	// Number of servers to skip before starting to collect the result set. Used for pagination.
+	Offset OptInt32 `json:",omitempty,omitzero"`
+}
+
+func unpackListTrendingServersParams(packed middleware.Parameters) (params ListTrendingServersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "multiplayerName",
+			In:   "path",
+		}
+		params.MultiplayerName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "window",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Window = v.(OptListTrendingServersWindow)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "orderBy",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderBy = v.(OptListTrendingServersOrderBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeListTrendingServersParams(args [1]string, argsEscaped bool, r *http.Request) (params ListTrendingServersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: multiplayerName.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "multiplayerName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.MultiplayerName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "multiplayerName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: window.
+	{
+		val := ListTrendingServersWindow("24h")
+		params.Window.SetTo(val)
+	}
+	// Decode query: window.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "window",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotWindowVal ListTrendingServersWindow
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotWindowVal = ListTrendingServersWindow(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Window.SetTo(paramsDotWindowVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Window.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "window",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: orderBy.
+	{
+		val := ListTrendingServersOrderBy("absolute")
+		params.OrderBy.SetTo(val)
+	}
+	// Decode query: orderBy.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "orderBy",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderByVal ListTrendingServersOrderBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderByVal = ListTrendingServersOrderBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrderBy.SetTo(paramsDotOrderByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OrderBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "orderBy",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int32(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int32(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchServersParams is parameters of searchServers operation.
+type SearchServersParams struct {
+	// Multiplayer platform name.
+	MultiplayerName string
+	// Case-insensitive substring of server name.
+	Q OptString `json:",omitempty,omitzero"`
+	// Server language.
+	Language OptString `json:",omitempty,omitzero"`
+	// Server gamemode.
+	Gamemode OptString `json:",omitempty,omitzero"`
+	// Minimum current players count.
+	MinPlayers OptInt32 `json:",omitempty,omitzero"`
+	// Maximum current players count.
+	MaxPlayers OptInt32 `json:",omitempty,omitzero"`
+	// Whether server is in the latest snapshot of the multiplayer.
+	Status OptSearchServersStatus `json:",omitempty,omitzero"`
+	// Maximum number of items to return in the response. Used for pagination.
+	Limit OptInt32 `json:",omitempty,omitzero"`
+	// Number of servers to skip before starting to collect the result set. Used for pagination.
+	Offset OptInt32 `json:",omitempty,omitzero"`
+}
+
+func unpackSearchServersParams(packed middleware.Parameters) (params SearchServersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "multiplayerName",
+			In:   "path",
+		}
+		params.MultiplayerName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Q = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "gamemode",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Gamemode = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "minPlayers",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MinPlayers = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "maxPlayers",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MaxPlayers = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptSearchServersStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeSearchServersParams(args [1]string, argsEscaped bool, r *http.Request) (params SearchServersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: multiplayerName.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "multiplayerName",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
 				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
-
-				params.MultiplayerName = c
+
+				params.MultiplayerName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "multiplayerName",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Q.SetTo(paramsDotQVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Q.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    128,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "multiplayerName",
-			In:   "path",
+			Name: "q",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: playersOrderAsc.
+	// Decode query: language.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "playersOrderAsc",
+			Name:    "language",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPlayersOrderAscVal bool
+				var paramsDotLanguageVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPlayersOrderAscVal = c
+					paramsDotLanguageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PlayersOrderAsc.SetTo(paramsDotPlayersOrderAscVal)
+				params.Language.SetTo(paramsDotLanguageVal)
 				return nil
 			}); err != nil {
 				return err
@@ -627,27 +2528,63 @@ func decodeListServerSummariesParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "playersOrderAsc",
+			Name: "language",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int32(100)
-		params.Limit.SetTo(val)
+	// Decode query: gamemode.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "gamemode",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotGamemodeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotGamemodeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Gamemode.SetTo(paramsDotGamemodeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "gamemode",
+			In:   "query",
+			Err:  err,
+		}
 	}
-	// Decode query: limit.
+	// Decode query: minPlayers.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "minPlayers",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
+				var paramsDotMinPlayersVal int32
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -659,24 +2596,24 @@ func decodeListServerSummariesParams(args [1]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotMinPlayersVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.MinPlayers.SetTo(paramsDotMinPlayersVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.MinPlayers.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
 							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -697,27 +2634,153 @@ func decodeListServerSummariesParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "minPlayers",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	// Decode query: maxPlayers.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "maxPlayers",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMaxPlayersVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMaxPlayersVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MaxPlayers.SetTo(paramsDotMaxPlayersVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MaxPlayers.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "maxPlayers",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: status.
+	{
+		val := SearchServersStatus("any")
+		params.Status.SetTo(val)
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal SearchServersStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = SearchServersStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
 	{
 		val := int32(100)
-		params.Offset.SetTo(val)
+		params.Limit.SetTo(val)
 	}
-	// Decode query: offset.
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int32
+				var paramsDotLimitVal int32
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -729,18 +2792,18 @@ func decodeListServerSummariesParams(args [1]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
+				if value, ok := params.Limit.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
 							MinSet:        true,
@@ -767,48 +2830,77 @@ func decodeListServerSummariesParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: includeOffline.
+	// Set default value for query: offset.
+	{
+		val := int32(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "includeOffline",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIncludeOfflineVal bool
+				var paramsDotOffsetVal int32
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToInt32(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotIncludeOfflineVal = c
+					paramsDotOffsetVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.IncludeOffline.SetTo(paramsDotIncludeOfflineVal)
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "includeOffline",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
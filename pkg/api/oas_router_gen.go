@@ -49,9 +49,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/multiplayer"
+		case '/': // Prefix: "/"
 
-			if l := len("/multiplayer"); len(elem) >= l && elem[0:l] == "/multiplayer" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -61,35 +61,35 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case '/': // Prefix: "/"
+			case 'm': // Prefix: "multiplayer"
 
-				if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				if l := len("multiplayer"); len(elem) >= l && elem[0:l] == "multiplayer" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				// Param: "multiplayerName"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
 				if len(elem) == 0 {
 					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/server"
+				case '/': // Prefix: "/"
 
-					if l := len("/server"); len(elem) >= l && elem[0:l] == "/server" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
+					// Param: "multiplayerName"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
 					if len(elem) == 0 {
 						break
 					}
@@ -102,32 +102,237 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 
-						// Param: "serverHost"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+						if len(elem) == 0 {
+							break
 						}
-						args[1] = elem[:idx]
-						elem = elem[idx:]
+						switch elem[0] {
+						case 's': // Prefix: "s"
 
-						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleGetServerRequest([2]string{
-									args[0],
-									args[1],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/statistics"
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "e"
+
+								if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "arch"
+
+									if l := len("arch"); len(elem) >= l && elem[0:l] == "arch" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleSearchServersRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "rver"
+
+									if l := len("rver"); len(elem) >= l && elem[0:l] == "rver" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "serverHost"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleGetServerRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'h': // Prefix: "history"
+
+												if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleListServerHistoryRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+											case 's': // Prefix: "statistics"
+
+												if l := len("statistics"); len(elem) >= l && elem[0:l] == "statistics" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleListServerStatisticsRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+											case 'u': // Prefix: "uptime"
+
+												if l := len("uptime"); len(elem) >= l && elem[0:l] == "uptime" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetServerUptimeRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+											}
+
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleListServerSummariesRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							case 't': // Prefix: "tatistics"
+
+								if l := len("tatistics"); len(elem) >= l && elem[0:l] == "tatistics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleListMultiplayerStatisticsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
 
-							if l := len("/statistics"); len(elem) >= l && elem[0:l] == "/statistics" {
+						case 't': // Prefix: "trending"
+
+							if l := len("trending"); len(elem) >= l && elem[0:l] == "trending" {
 								elem = elem[l:]
 							} else {
 								break
@@ -137,9 +342,8 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleListServerStatisticsRequest([2]string{
+									s.handleListTrendingServersRequest([1]string{
 										args[0],
-										args[1],
 									}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "GET")
@@ -150,50 +354,73 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
-					case 's': // Prefix: "s"
+					}
 
-						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+				case 's': // Prefix: "s/summaries"
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleListServerSummariesRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
+					if l := len("s/summaries"); len(elem) >= l && elem[0:l] == "s/summaries" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-							return
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListMultiplayerSummariesRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
 						}
 
+						return
 					}
 
 				}
 
-			case 's': // Prefix: "s/summaries"
+			case 'v': // Prefix: "v2/multiplayer/"
 
-				if l := len("s/summaries"); len(elem) >= l && elem[0:l] == "s/summaries" {
+				if l := len("v2/multiplayer/"); len(elem) >= l && elem[0:l] == "v2/multiplayer/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
+				// Param: "multiplayerName"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
 				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleListMultiplayerSummariesRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/servers"
+
+					if l := len("/servers"); len(elem) >= l && elem[0:l] == "/servers" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListServerSummariesPageRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
 					}
 
-					return
 				}
 
 			}
@@ -278,9 +505,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/multiplayer"
+		case '/': // Prefix: "/"
 
-			if l := len("/multiplayer"); len(elem) >= l && elem[0:l] == "/multiplayer" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -290,35 +517,35 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case '/': // Prefix: "/"
+			case 'm': // Prefix: "multiplayer"
 
-				if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				if l := len("multiplayer"); len(elem) >= l && elem[0:l] == "multiplayer" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				// Param: "multiplayerName"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
 				if len(elem) == 0 {
 					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/server"
+				case '/': // Prefix: "/"
 
-					if l := len("/server"); len(elem) >= l && elem[0:l] == "/server" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
+					// Param: "multiplayerName"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
 					if len(elem) == 0 {
 						break
 					}
@@ -331,33 +558,247 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 
-						// Param: "serverHost"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[1] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								r.name = GetServerOperation
-								r.summary = "Get server by host"
-								r.operationID = "getServer"
-								r.pathPattern = "/multiplayer/{multiplayerName}/server/{serverHost}"
-								r.args = args
-								r.count = 2
-								return r, true
-							default:
-								return
-							}
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/statistics"
+						case 's': // Prefix: "s"
 
-							if l := len("/statistics"); len(elem) >= l && elem[0:l] == "/statistics" {
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "e"
+
+								if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "arch"
+
+									if l := len("arch"); len(elem) >= l && elem[0:l] == "arch" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = SearchServersOperation
+											r.summary = "Search servers of a multiplayer platform"
+											r.operationID = "searchServers"
+											r.pathPattern = "/multiplayer/{multiplayerName}/search"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "rver"
+
+									if l := len("rver"); len(elem) >= l && elem[0:l] == "rver" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "serverHost"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = GetServerOperation
+												r.summary = "Get server by host"
+												r.operationID = "getServer"
+												r.pathPattern = "/multiplayer/{multiplayerName}/server/{serverHost}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'h': // Prefix: "history"
+
+												if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = ListServerHistoryOperation
+														r.summary = "List server metadata changes, the newest first"
+														r.operationID = "listServerHistory"
+														r.pathPattern = "/multiplayer/{multiplayerName}/server/{serverHost}/history"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											case 's': // Prefix: "statistics"
+
+												if l := len("statistics"); len(elem) >= l && elem[0:l] == "statistics" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = ListServerStatisticsOperation
+														r.summary = "Get server statistics by host"
+														r.operationID = "listServerStatistics"
+														r.pathPattern = "/multiplayer/{multiplayerName}/server/{serverHost}/statistics"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											case 'u': // Prefix: "uptime"
+
+												if l := len("uptime"); len(elem) >= l && elem[0:l] == "uptime" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetServerUptimeOperation
+														r.summary = "Get server availability by successful collection runs"
+														r.operationID = "getServerUptime"
+														r.pathPattern = "/multiplayer/{multiplayerName}/server/{serverHost}/uptime"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = ListServerSummariesOperation
+												r.summary = "List servers for a multiplayer platform"
+												r.operationID = "listServerSummaries"
+												r.pathPattern = "/multiplayer/{multiplayerName}/servers"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 't': // Prefix: "tatistics"
+
+								if l := len("tatistics"); len(elem) >= l && elem[0:l] == "tatistics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ListMultiplayerStatisticsOperation
+										r.summary = "Get multiplayer statistics"
+										r.operationID = "listMultiplayerStatistics"
+										r.pathPattern = "/multiplayer/{multiplayerName}/statistics"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 't': // Prefix: "trending"
+
+							if l := len("trending"); len(elem) >= l && elem[0:l] == "trending" {
 								elem = elem[l:]
 							} else {
 								break
@@ -367,12 +808,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = ListServerStatisticsOperation
-									r.summary = "Get server statistics by host"
-									r.operationID = "listServerStatistics"
-									r.pathPattern = "/multiplayer/{multiplayerName}/server/{serverHost}/statistics"
+									r.name = ListTrendingServersOperation
+									r.summary = "List trending servers for a multiplayer platform"
+									r.operationID = "listTrendingServers"
+									r.pathPattern = "/multiplayer/{multiplayerName}/trending"
 									r.args = args
-									r.count = 2
+									r.count = 1
 									return r, true
 								default:
 									return
@@ -381,56 +822,79 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						}
 
-					case 's': // Prefix: "s"
+					}
 
-						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+				case 's': // Prefix: "s/summaries"
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = ListServerSummariesOperation
-								r.summary = "List servers for a multiplayer platform"
-								r.operationID = "listServerSummaries"
-								r.pathPattern = "/multiplayer/{multiplayerName}/servers"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
+					if l := len("s/summaries"); len(elem) >= l && elem[0:l] == "s/summaries" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListMultiplayerSummariesOperation
+							r.summary = "Get a summary of multiplayer platforms"
+							r.operationID = "listMultiplayerSummaries"
+							r.pathPattern = "/multiplayers/summaries"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
 					}
 
 				}
 
-			case 's': // Prefix: "s/summaries"
+			case 'v': // Prefix: "v2/multiplayer/"
 
-				if l := len("s/summaries"); len(elem) >= l && elem[0:l] == "s/summaries" {
+				if l := len("v2/multiplayer/"); len(elem) >= l && elem[0:l] == "v2/multiplayer/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
+				// Param: "multiplayerName"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
 				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = ListMultiplayerSummariesOperation
-						r.summary = "Get a summary of multiplayer platforms"
-						r.operationID = "listMultiplayerSummaries"
-						r.pathPattern = "/multiplayers/summaries"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/servers"
+
+					if l := len("/servers"); len(elem) >= l && elem[0:l] == "/servers" {
+						elem = elem[l:]
+					} else {
+						break
 					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListServerSummariesPageOperation
+							r.summary = "List servers for a multiplayer platform with cursor pagination"
+							r.operationID = "listServerSummariesPage"
+							r.pathPattern = "/v2/multiplayer/{multiplayerName}/servers"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
 				}
 
 			}
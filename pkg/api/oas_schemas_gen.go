@@ -10,12 +10,23 @@ import (
 
 // Ref: #/components/schemas/DetailedServer
 type DetailedServer struct {
-	Name         string      `json:"name"`
-	URL          OptString   `json:"url"`
-	Gamemode     OptString   `json:"gamemode"`
-	Language     OptString   `json:"language"`
-	PlayersCount OptInt64    `json:"playersCount"`
-	CollectedAt  OptDateTime `json:"collectedAt"`
+	Name         string    `json:"name"`
+	URL          OptString `json:"url"`
+	Gamemode     OptString `json:"gamemode"`
+	Language     OptString `json:"language"`
+	PlayersCount OptInt64  `json:"playersCount"`
+	// Server capacity, absent when platform doesn't report it.
+	MaxPlayers OptInt32 `json:"maxPlayers"`
+	// Players record of the server, absent when platform doesn't report it.
+	PeakPlayers OptInt32 `json:"peakPlayers"`
+	// Current map of the server, absent when platform doesn't report it.
+	Map     OptString `json:"map"`
+	Version OptString `json:"version"`
+	// Absent when platform doesn't report it.
+	Passworded  OptBool         `json:"passworded"`
+	Tags        []string        `json:"tags"`
+	CollectedAt OptDateTime     `json:"collectedAt"`
+	Uptime      OptServerUptime `json:"uptime"`
 }
 
 // GetName returns the value of Name.
@@ -43,11 +54,46 @@ func (s *DetailedServer) GetPlayersCount() OptInt64 {
 	return s.PlayersCount
 }
 
+// GetMaxPlayers returns the value of MaxPlayers.
+func (s *DetailedServer) GetMaxPlayers() OptInt32 {
+	return s.MaxPlayers
+}
+
+// GetPeakPlayers returns the value of PeakPlayers.
+func (s *DetailedServer) GetPeakPlayers() OptInt32 {
+	return s.PeakPlayers
+}
+
+// GetMap returns the value of Map.
+func (s *DetailedServer) GetMap() OptString {
+	return s.Map
+}
+
+// GetVersion returns the value of Version.
+func (s *DetailedServer) GetVersion() OptString {
+	return s.Version
+}
+
+// GetPassworded returns the value of Passworded.
+func (s *DetailedServer) GetPassworded() OptBool {
+	return s.Passworded
+}
+
+// GetTags returns the value of Tags.
+func (s *DetailedServer) GetTags() []string {
+	return s.Tags
+}
+
 // GetCollectedAt returns the value of CollectedAt.
 func (s *DetailedServer) GetCollectedAt() OptDateTime {
 	return s.CollectedAt
 }
 
+// GetUptime returns the value of Uptime.
+func (s *DetailedServer) GetUptime() OptServerUptime {
+	return s.Uptime
+}
+
 // SetName sets the value of Name.
 func (s *DetailedServer) SetName(val string) {
 	s.Name = val
@@ -73,48 +119,144 @@ func (s *DetailedServer) SetPlayersCount(val OptInt64) {
 	s.PlayersCount = val
 }
 
+// SetMaxPlayers sets the value of MaxPlayers.
+func (s *DetailedServer) SetMaxPlayers(val OptInt32) {
+	s.MaxPlayers = val
+}
+
+// SetPeakPlayers sets the value of PeakPlayers.
+func (s *DetailedServer) SetPeakPlayers(val OptInt32) {
+	s.PeakPlayers = val
+}
+
+// SetMap sets the value of Map.
+func (s *DetailedServer) SetMap(val OptString) {
+	s.Map = val
+}
+
+// SetVersion sets the value of Version.
+func (s *DetailedServer) SetVersion(val OptString) {
+	s.Version = val
+}
+
+// SetPassworded sets the value of Passworded.
+func (s *DetailedServer) SetPassworded(val OptBool) {
+	s.Passworded = val
+}
+
+// SetTags sets the value of Tags.
+func (s *DetailedServer) SetTags(val []string) {
+	s.Tags = val
+}
+
 // SetCollectedAt sets the value of CollectedAt.
 func (s *DetailedServer) SetCollectedAt(val OptDateTime) {
 	s.CollectedAt = val
 }
 
+// SetUptime sets the value of Uptime.
+func (s *DetailedServer) SetUptime(val OptServerUptime) {
+	s.Uptime = val
+}
+
 func (*DetailedServer) getServerRes() {}
 
+// Ref: #/components/schemas/FacetValue
+type FacetValue struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// GetValue returns the value of Value.
+func (s *FacetValue) GetValue() string {
+	return s.Value
+}
+
+// GetCount returns the value of Count.
+func (s *FacetValue) GetCount() int64 {
+	return s.Count
+}
+
+// SetValue sets the value of Value.
+func (s *FacetValue) SetValue(val string) {
+	s.Value = val
+}
+
+// SetCount sets the value of Count.
+func (s *FacetValue) SetCount(val int64) {
+	s.Count = val
+}
+
 // GetServerNotFound is response for GetServer operation.
 type GetServerNotFound struct{}
 
 func (*GetServerNotFound) getServerRes() {}
 
-// ListServerStatisticsNotFound is response for ListServerStatistics operation.
-type ListServerStatisticsNotFound struct{}
+// GetServerUptimeNotFound is response for GetServerUptime operation.
+type GetServerUptimeNotFound struct{}
 
-func (*ListServerStatisticsNotFound) listServerStatisticsRes() {}
+func (*GetServerUptimeNotFound) getServerUptimeRes() {}
 
-type ListServerStatisticsOKApplicationJSON []ServerStatisticPoint
+// ListMultiplayerStatisticsBadRequest is response for ListMultiplayerStatistics operation.
+type ListMultiplayerStatisticsBadRequest struct{}
 
-func (*ListServerStatisticsOKApplicationJSON) listServerStatisticsRes() {}
+func (*ListMultiplayerStatisticsBadRequest) listMultiplayerStatisticsRes() {}
+
+// ListMultiplayerStatisticsNotFound is response for ListMultiplayerStatistics operation.
+type ListMultiplayerStatisticsNotFound struct{}
+
+func (*ListMultiplayerStatisticsNotFound) listMultiplayerStatisticsRes() {}
+
+type ListMultiplayerStatisticsOKApplicationJSON []MultiplayerStatisticPoint
+
+func (*ListMultiplayerStatisticsOKApplicationJSON) listMultiplayerStatisticsRes() {}
+
+// ListServerHistoryNotFound is response for ListServerHistory operation.
+type ListServerHistoryNotFound struct{}
 
-type ListServerStatisticsPrecision string
+func (*ListServerHistoryNotFound) listServerHistoryRes() {}
+
+type ListServerHistoryOKApplicationJSON []ServerChange
+
+func (*ListServerHistoryOKApplicationJSON) listServerHistoryRes() {}
+
+type ListServerStatisticsAggregatesItem string
 
 const (
-	ListServerStatisticsPrecisionPerHour ListServerStatisticsPrecision = "perHour"
-	ListServerStatisticsPrecisionPerDay  ListServerStatisticsPrecision = "perDay"
+	ListServerStatisticsAggregatesItemAvg   ListServerStatisticsAggregatesItem = "avg"
+	ListServerStatisticsAggregatesItemMin   ListServerStatisticsAggregatesItem = "min"
+	ListServerStatisticsAggregatesItemMax   ListServerStatisticsAggregatesItem = "max"
+	ListServerStatisticsAggregatesItemP50   ListServerStatisticsAggregatesItem = "p50"
+	ListServerStatisticsAggregatesItemP95   ListServerStatisticsAggregatesItem = "p95"
+	ListServerStatisticsAggregatesItemCount ListServerStatisticsAggregatesItem = "count"
 )
 
-// AllValues returns all ListServerStatisticsPrecision values.
-func (ListServerStatisticsPrecision) AllValues() []ListServerStatisticsPrecision {
-	return []ListServerStatisticsPrecision{
-		ListServerStatisticsPrecisionPerHour,
-		ListServerStatisticsPrecisionPerDay,
+// AllValues returns all ListServerStatisticsAggregatesItem values.
+func (ListServerStatisticsAggregatesItem) AllValues() []ListServerStatisticsAggregatesItem {
+	return []ListServerStatisticsAggregatesItem{
+		ListServerStatisticsAggregatesItemAvg,
+		ListServerStatisticsAggregatesItemMin,
+		ListServerStatisticsAggregatesItemMax,
+		ListServerStatisticsAggregatesItemP50,
+		ListServerStatisticsAggregatesItemP95,
+		ListServerStatisticsAggregatesItemCount,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s ListServerStatisticsPrecision) MarshalText() ([]byte, error) {
+func (s ListServerStatisticsAggregatesItem) MarshalText() ([]byte, error) {
 	switch s {
-	case ListServerStatisticsPrecisionPerHour:
+	case ListServerStatisticsAggregatesItemAvg:
+		return []byte(s), nil
+	case ListServerStatisticsAggregatesItemMin:
 		return []byte(s), nil
-	case ListServerStatisticsPrecisionPerDay:
+	case ListServerStatisticsAggregatesItemMax:
+		return []byte(s), nil
+	case ListServerStatisticsAggregatesItemP50:
+		return []byte(s), nil
+	case ListServerStatisticsAggregatesItemP95:
+		return []byte(s), nil
+	case ListServerStatisticsAggregatesItemCount:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -122,19 +264,45 @@ func (s ListServerStatisticsPrecision) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ListServerStatisticsPrecision) UnmarshalText(data []byte) error {
-	switch ListServerStatisticsPrecision(data) {
-	case ListServerStatisticsPrecisionPerHour:
-		*s = ListServerStatisticsPrecisionPerHour
+func (s *ListServerStatisticsAggregatesItem) UnmarshalText(data []byte) error {
+	switch ListServerStatisticsAggregatesItem(data) {
+	case ListServerStatisticsAggregatesItemAvg:
+		*s = ListServerStatisticsAggregatesItemAvg
+		return nil
+	case ListServerStatisticsAggregatesItemMin:
+		*s = ListServerStatisticsAggregatesItemMin
 		return nil
-	case ListServerStatisticsPrecisionPerDay:
-		*s = ListServerStatisticsPrecisionPerDay
+	case ListServerStatisticsAggregatesItemMax:
+		*s = ListServerStatisticsAggregatesItemMax
+		return nil
+	case ListServerStatisticsAggregatesItemP50:
+		*s = ListServerStatisticsAggregatesItemP50
+		return nil
+	case ListServerStatisticsAggregatesItemP95:
+		*s = ListServerStatisticsAggregatesItemP95
+		return nil
+	case ListServerStatisticsAggregatesItemCount:
+		*s = ListServerStatisticsAggregatesItemCount
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// ListServerStatisticsBadRequest is response for ListServerStatistics operation.
+type ListServerStatisticsBadRequest struct{}
+
+func (*ListServerStatisticsBadRequest) listServerStatisticsRes() {}
+
+// ListServerStatisticsNotFound is response for ListServerStatistics operation.
+type ListServerStatisticsNotFound struct{}
+
+func (*ListServerStatisticsNotFound) listServerStatisticsRes() {}
+
+type ListServerStatisticsOKApplicationJSON []ServerStatisticPoint
+
+func (*ListServerStatisticsOKApplicationJSON) listServerStatisticsRes() {}
+
 // ListServerSummariesNotFound is response for ListServerSummaries operation.
 type ListServerSummariesNotFound struct{}
 
@@ -144,6 +312,148 @@ type ListServerSummariesOKApplicationJSON []ServerSummary
 
 func (*ListServerSummariesOKApplicationJSON) listServerSummariesRes() {}
 
+// ListServerSummariesPageBadRequest is response for ListServerSummariesPage operation.
+type ListServerSummariesPageBadRequest struct{}
+
+func (*ListServerSummariesPageBadRequest) listServerSummariesPageRes() {}
+
+// ListTrendingServersBadRequest is response for ListTrendingServers operation.
+type ListTrendingServersBadRequest struct{}
+
+func (*ListTrendingServersBadRequest) listTrendingServersRes() {}
+
+type ListTrendingServersOKApplicationJSON []TrendingServer
+
+func (*ListTrendingServersOKApplicationJSON) listTrendingServersRes() {}
+
+type ListTrendingServersOrderBy string
+
+const (
+	ListTrendingServersOrderByAbsolute ListTrendingServersOrderBy = "absolute"
+	ListTrendingServersOrderByRelative ListTrendingServersOrderBy = "relative"
+)
+
+// AllValues returns all ListTrendingServersOrderBy values.
+func (ListTrendingServersOrderBy) AllValues() []ListTrendingServersOrderBy {
+	return []ListTrendingServersOrderBy{
+		ListTrendingServersOrderByAbsolute,
+		ListTrendingServersOrderByRelative,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListTrendingServersOrderBy) MarshalText() ([]byte, error) {
+	switch s {
+	case ListTrendingServersOrderByAbsolute:
+		return []byte(s), nil
+	case ListTrendingServersOrderByRelative:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListTrendingServersOrderBy) UnmarshalText(data []byte) error {
+	switch ListTrendingServersOrderBy(data) {
+	case ListTrendingServersOrderByAbsolute:
+		*s = ListTrendingServersOrderByAbsolute
+		return nil
+	case ListTrendingServersOrderByRelative:
+		*s = ListTrendingServersOrderByRelative
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListTrendingServersWindow string
+
+const (
+	ListTrendingServersWindow24h ListTrendingServersWindow = "24h"
+	ListTrendingServersWindow7d  ListTrendingServersWindow = "7d"
+	ListTrendingServersWindow30d ListTrendingServersWindow = "30d"
+)
+
+// AllValues returns all ListTrendingServersWindow values.
+func (ListTrendingServersWindow) AllValues() []ListTrendingServersWindow {
+	return []ListTrendingServersWindow{
+		ListTrendingServersWindow24h,
+		ListTrendingServersWindow7d,
+		ListTrendingServersWindow30d,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListTrendingServersWindow) MarshalText() ([]byte, error) {
+	switch s {
+	case ListTrendingServersWindow24h:
+		return []byte(s), nil
+	case ListTrendingServersWindow7d:
+		return []byte(s), nil
+	case ListTrendingServersWindow30d:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListTrendingServersWindow) UnmarshalText(data []byte) error {
+	switch ListTrendingServersWindow(data) {
+	case ListTrendingServersWindow24h:
+		*s = ListTrendingServersWindow24h
+		return nil
+	case ListTrendingServersWindow7d:
+		*s = ListTrendingServersWindow7d
+		return nil
+	case ListTrendingServersWindow30d:
+		*s = ListTrendingServersWindow30d
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/MultiplayerStatisticPoint
+type MultiplayerStatisticPoint struct {
+	CollectedAt time.Time `json:"collectedAt"`
+	// Average total players count in the bucket.
+	PlayersCount int64 `json:"playersCount"`
+	// Average online servers count in the bucket.
+	ServersCount int64 `json:"serversCount"`
+}
+
+// GetCollectedAt returns the value of CollectedAt.
+func (s *MultiplayerStatisticPoint) GetCollectedAt() time.Time {
+	return s.CollectedAt
+}
+
+// GetPlayersCount returns the value of PlayersCount.
+func (s *MultiplayerStatisticPoint) GetPlayersCount() int64 {
+	return s.PlayersCount
+}
+
+// GetServersCount returns the value of ServersCount.
+func (s *MultiplayerStatisticPoint) GetServersCount() int64 {
+	return s.ServersCount
+}
+
+// SetCollectedAt sets the value of CollectedAt.
+func (s *MultiplayerStatisticPoint) SetCollectedAt(val time.Time) {
+	s.CollectedAt = val
+}
+
+// SetPlayersCount sets the value of PlayersCount.
+func (s *MultiplayerStatisticPoint) SetPlayersCount(val int64) {
+	s.PlayersCount = val
+}
+
+// SetServersCount sets the value of ServersCount.
+func (s *MultiplayerStatisticPoint) SetServersCount(val int64) {
+	s.ServersCount = val
+}
+
 // Ref: #/components/schemas/MultiplayerSummary
 type MultiplayerSummary struct {
 	// Multiplayer platform name.
@@ -171,6 +481,51 @@ func (s *MultiplayerSummary) SetPlayersCount(val int64) {
 	s.PlayersCount = val
 }
 
+// NewNilInt32 returns new NilInt32 with value set to v.
+func NewNilInt32(v int32) NilInt32 {
+	return NilInt32{
+		Value: v,
+	}
+}
+
+// NilInt32 is nullable int32.
+type NilInt32 struct {
+	Value int32
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt32) SetTo(v int32) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt32) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt32) SetToNull() {
+	o.Null = true
+	var v int32
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt32) Get() (v int32, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptBool returns new OptBool with value set to v.
 func NewOptBool(v bool) OptBool {
 	return OptBool{
@@ -263,6 +618,52 @@ func (o OptDateTime) Or(d time.Time) time.Time {
 	return d
 }
 
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt32 returns new OptInt32 with value set to v.
 func NewOptInt32(v int32) OptInt32 {
 	return OptInt32{
@@ -355,38 +756,38 @@ func (o OptInt64) Or(d int64) int64 {
 	return d
 }
 
-// NewOptListServerStatisticsPrecision returns new OptListServerStatisticsPrecision with value set to v.
-func NewOptListServerStatisticsPrecision(v ListServerStatisticsPrecision) OptListServerStatisticsPrecision {
-	return OptListServerStatisticsPrecision{
+// NewOptListTrendingServersOrderBy returns new OptListTrendingServersOrderBy with value set to v.
+func NewOptListTrendingServersOrderBy(v ListTrendingServersOrderBy) OptListTrendingServersOrderBy {
+	return OptListTrendingServersOrderBy{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptListServerStatisticsPrecision is optional ListServerStatisticsPrecision.
-type OptListServerStatisticsPrecision struct {
-	Value ListServerStatisticsPrecision
+// OptListTrendingServersOrderBy is optional ListTrendingServersOrderBy.
+type OptListTrendingServersOrderBy struct {
+	Value ListTrendingServersOrderBy
 	Set   bool
 }
 
-// IsSet returns true if OptListServerStatisticsPrecision was set.
-func (o OptListServerStatisticsPrecision) IsSet() bool { return o.Set }
+// IsSet returns true if OptListTrendingServersOrderBy was set.
+func (o OptListTrendingServersOrderBy) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptListServerStatisticsPrecision) Reset() {
-	var v ListServerStatisticsPrecision
+func (o *OptListTrendingServersOrderBy) Reset() {
+	var v ListTrendingServersOrderBy
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptListServerStatisticsPrecision) SetTo(v ListServerStatisticsPrecision) {
+func (o *OptListTrendingServersOrderBy) SetTo(v ListTrendingServersOrderBy) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptListServerStatisticsPrecision) Get() (v ListServerStatisticsPrecision, ok bool) {
+func (o OptListTrendingServersOrderBy) Get() (v ListTrendingServersOrderBy, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -394,45 +795,45 @@ func (o OptListServerStatisticsPrecision) Get() (v ListServerStatisticsPrecision
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptListServerStatisticsPrecision) Or(d ListServerStatisticsPrecision) ListServerStatisticsPrecision {
+func (o OptListTrendingServersOrderBy) Or(d ListTrendingServersOrderBy) ListTrendingServersOrderBy {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptListTrendingServersWindow returns new OptListTrendingServersWindow with value set to v.
+func NewOptListTrendingServersWindow(v ListTrendingServersWindow) OptListTrendingServersWindow {
+	return OptListTrendingServersWindow{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptListTrendingServersWindow is optional ListTrendingServersWindow.
+type OptListTrendingServersWindow struct {
+	Value ListTrendingServersWindow
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptListTrendingServersWindow was set.
+func (o OptListTrendingServersWindow) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptListTrendingServersWindow) Reset() {
+	var v ListTrendingServersWindow
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptListTrendingServersWindow) SetTo(v ListTrendingServersWindow) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptListTrendingServersWindow) Get() (v ListTrendingServersWindow, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -440,48 +841,693 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptListTrendingServersWindow) Or(d ListTrendingServersWindow) ListTrendingServersWindow {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// Ref: #/components/schemas/ServerStatisticPoint
-type ServerStatisticPoint struct {
-	CollectedAt  time.Time `json:"collectedAt"`
-	PlayersCount int32     `json:"playersCount"`
+// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
+func NewOptNilFloat64(v float64) OptNilFloat64 {
+	return OptNilFloat64{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetCollectedAt returns the value of CollectedAt.
-func (s *ServerStatisticPoint) GetCollectedAt() time.Time {
-	return s.CollectedAt
+// OptNilFloat64 is optional nullable float64.
+type OptNilFloat64 struct {
+	Value float64
+	Set   bool
+	Null  bool
 }
 
-// GetPlayersCount returns the value of PlayersCount.
-func (s *ServerStatisticPoint) GetPlayersCount() int32 {
-	return s.PlayersCount
-}
+// IsSet returns true if OptNilFloat64 was set.
+func (o OptNilFloat64) IsSet() bool { return o.Set }
 
-// SetCollectedAt sets the value of CollectedAt.
-func (s *ServerStatisticPoint) SetCollectedAt(val time.Time) {
-	s.CollectedAt = val
+// Reset unsets value.
+func (o *OptNilFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+	o.Null = false
 }
 
-// SetPlayersCount sets the value of PlayersCount.
-func (s *ServerStatisticPoint) SetPlayersCount(val int32) {
-	s.PlayersCount = val
+// SetTo sets value to v.
+func (o *OptNilFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
 }
 
-// Ref: #/components/schemas/ServerSummary
-type ServerSummary struct {
-	Host         string `json:"host"`
-	Name         string `json:"name"`
-	PlayersCount int32  `json:"playersCount"`
+// IsNull returns true if value is Null.
+func (o OptNilFloat64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilFloat64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v float64
+	o.Value = v
 }
 
-// GetHost returns the value of Host.
-func (s *ServerSummary) GetHost() string {
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchServersStatus returns new OptSearchServersStatus with value set to v.
+func NewOptSearchServersStatus(v SearchServersStatus) OptSearchServersStatus {
+	return OptSearchServersStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchServersStatus is optional SearchServersStatus.
+type OptSearchServersStatus struct {
+	Value SearchServersStatus
+	Set   bool
+}
+
+// IsSet returns true if OptSearchServersStatus was set.
+func (o OptSearchServersStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchServersStatus) Reset() {
+	var v SearchServersStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchServersStatus) SetTo(v SearchServersStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchServersStatus) Get() (v SearchServersStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchServersStatus) Or(d SearchServersStatus) SearchServersStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptServerUptime returns new OptServerUptime with value set to v.
+func NewOptServerUptime(v ServerUptime) OptServerUptime {
+	return OptServerUptime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptServerUptime is optional ServerUptime.
+type OptServerUptime struct {
+	Value ServerUptime
+	Set   bool
+}
+
+// IsSet returns true if OptServerUptime was set.
+func (o OptServerUptime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptServerUptime) Reset() {
+	var v ServerUptime
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptServerUptime) SetTo(v ServerUptime) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptServerUptime) Get() (v ServerUptime, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptServerUptime) Or(d ServerUptime) ServerUptime {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStatisticsPrecision returns new OptStatisticsPrecision with value set to v.
+func NewOptStatisticsPrecision(v StatisticsPrecision) OptStatisticsPrecision {
+	return OptStatisticsPrecision{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStatisticsPrecision is optional StatisticsPrecision.
+type OptStatisticsPrecision struct {
+	Value StatisticsPrecision
+	Set   bool
+}
+
+// IsSet returns true if OptStatisticsPrecision was set.
+func (o OptStatisticsPrecision) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStatisticsPrecision) Reset() {
+	var v StatisticsPrecision
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStatisticsPrecision) SetTo(v StatisticsPrecision) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStatisticsPrecision) Get() (v StatisticsPrecision, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStatisticsPrecision) Or(d StatisticsPrecision) StatisticsPrecision {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// SearchServersBadRequest is response for SearchServers operation.
+type SearchServersBadRequest struct{}
+
+func (*SearchServersBadRequest) searchServersRes() {}
+
+type SearchServersStatus string
+
+const (
+	SearchServersStatusAny     SearchServersStatus = "any"
+	SearchServersStatusOnline  SearchServersStatus = "online"
+	SearchServersStatusOffline SearchServersStatus = "offline"
+)
+
+// AllValues returns all SearchServersStatus values.
+func (SearchServersStatus) AllValues() []SearchServersStatus {
+	return []SearchServersStatus{
+		SearchServersStatusAny,
+		SearchServersStatusOnline,
+		SearchServersStatusOffline,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchServersStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchServersStatusAny:
+		return []byte(s), nil
+	case SearchServersStatusOnline:
+		return []byte(s), nil
+	case SearchServersStatusOffline:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchServersStatus) UnmarshalText(data []byte) error {
+	switch SearchServersStatus(data) {
+	case SearchServersStatusAny:
+		*s = SearchServersStatusAny
+		return nil
+	case SearchServersStatusOnline:
+		*s = SearchServersStatusOnline
+		return nil
+	case SearchServersStatusOffline:
+		*s = SearchServersStatusOffline
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ServerChange
+type ServerChange struct {
+	Field     ServerChangeField `json:"field"`
+	OldValue  string            `json:"oldValue"`
+	NewValue  string            `json:"newValue"`
+	ChangedAt time.Time         `json:"changedAt"`
+}
+
+// GetField returns the value of Field.
+func (s *ServerChange) GetField() ServerChangeField {
+	return s.Field
+}
+
+// GetOldValue returns the value of OldValue.
+func (s *ServerChange) GetOldValue() string {
+	return s.OldValue
+}
+
+// GetNewValue returns the value of NewValue.
+func (s *ServerChange) GetNewValue() string {
+	return s.NewValue
+}
+
+// GetChangedAt returns the value of ChangedAt.
+func (s *ServerChange) GetChangedAt() time.Time {
+	return s.ChangedAt
+}
+
+// SetField sets the value of Field.
+func (s *ServerChange) SetField(val ServerChangeField) {
+	s.Field = val
+}
+
+// SetOldValue sets the value of OldValue.
+func (s *ServerChange) SetOldValue(val string) {
+	s.OldValue = val
+}
+
+// SetNewValue sets the value of NewValue.
+func (s *ServerChange) SetNewValue(val string) {
+	s.NewValue = val
+}
+
+// SetChangedAt sets the value of ChangedAt.
+func (s *ServerChange) SetChangedAt(val time.Time) {
+	s.ChangedAt = val
+}
+
+type ServerChangeField string
+
+const (
+	ServerChangeFieldName     ServerChangeField = "name"
+	ServerChangeFieldURL      ServerChangeField = "url"
+	ServerChangeFieldGamemode ServerChangeField = "gamemode"
+	ServerChangeFieldLanguage ServerChangeField = "language"
+)
+
+// AllValues returns all ServerChangeField values.
+func (ServerChangeField) AllValues() []ServerChangeField {
+	return []ServerChangeField{
+		ServerChangeFieldName,
+		ServerChangeFieldURL,
+		ServerChangeFieldGamemode,
+		ServerChangeFieldLanguage,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ServerChangeField) MarshalText() ([]byte, error) {
+	switch s {
+	case ServerChangeFieldName:
+		return []byte(s), nil
+	case ServerChangeFieldURL:
+		return []byte(s), nil
+	case ServerChangeFieldGamemode:
+		return []byte(s), nil
+	case ServerChangeFieldLanguage:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ServerChangeField) UnmarshalText(data []byte) error {
+	switch ServerChangeField(data) {
+	case ServerChangeFieldName:
+		*s = ServerChangeFieldName
+		return nil
+	case ServerChangeFieldURL:
+		*s = ServerChangeFieldURL
+		return nil
+	case ServerChangeFieldGamemode:
+		*s = ServerChangeFieldGamemode
+		return nil
+	case ServerChangeFieldLanguage:
+		*s = ServerChangeFieldLanguage
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ServerFacets
+type ServerFacets struct {
+	Languages []FacetValue `json:"languages"`
+	Gamemodes []FacetValue `json:"gamemodes"`
+}
+
+// GetLanguages returns the value of Languages.
+func (s *ServerFacets) GetLanguages() []FacetValue {
+	return s.Languages
+}
+
+// GetGamemodes returns the value of Gamemodes.
+func (s *ServerFacets) GetGamemodes() []FacetValue {
+	return s.Gamemodes
+}
+
+// SetLanguages sets the value of Languages.
+func (s *ServerFacets) SetLanguages(val []FacetValue) {
+	s.Languages = val
+}
+
+// SetGamemodes sets the value of Gamemodes.
+func (s *ServerFacets) SetGamemodes(val []FacetValue) {
+	s.Gamemodes = val
+}
+
+// Ref: #/components/schemas/ServerSearchResult
+type ServerSearchResult struct {
+	Servers []ServerSummary `json:"servers"`
+	// Count of all found servers.
+	Total  int64        `json:"total"`
+	Facets ServerFacets `json:"facets"`
+}
+
+// GetServers returns the value of Servers.
+func (s *ServerSearchResult) GetServers() []ServerSummary {
+	return s.Servers
+}
+
+// GetTotal returns the value of Total.
+func (s *ServerSearchResult) GetTotal() int64 {
+	return s.Total
+}
+
+// GetFacets returns the value of Facets.
+func (s *ServerSearchResult) GetFacets() ServerFacets {
+	return s.Facets
+}
+
+// SetServers sets the value of Servers.
+func (s *ServerSearchResult) SetServers(val []ServerSummary) {
+	s.Servers = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ServerSearchResult) SetTotal(val int64) {
+	s.Total = val
+}
+
+// SetFacets sets the value of Facets.
+func (s *ServerSearchResult) SetFacets(val ServerFacets) {
+	s.Facets = val
+}
+
+func (*ServerSearchResult) searchServersRes() {}
+
+// Ref: #/components/schemas/ServerStatisticPoint
+type ServerStatisticPoint struct {
+	CollectedAt time.Time `json:"collectedAt"`
+	// Status of the server in the bucket, offline means that server was missing from successful
+	// collections,
+	// collectionFailed means that collections of the multiplayer failed and unknown means that there
+	// were no collections.
+	Status ServerStatisticPointStatus `json:"status"`
+	// Average players count in the bucket, null when server is not online.
+	PlayersCount NilInt32 `json:"playersCount"`
+	PlayersMin   OptInt32 `json:"playersMin"`
+	PlayersMax   OptInt32 `json:"playersMax"`
+	PlayersP50   OptInt32 `json:"playersP50"`
+	PlayersP95   OptInt32 `json:"playersP95"`
+	// Count of collected samples in the bucket.
+	Samples OptInt64 `json:"samples"`
+}
+
+// GetCollectedAt returns the value of CollectedAt.
+func (s *ServerStatisticPoint) GetCollectedAt() time.Time {
+	return s.CollectedAt
+}
+
+// GetStatus returns the value of Status.
+func (s *ServerStatisticPoint) GetStatus() ServerStatisticPointStatus {
+	return s.Status
+}
+
+// GetPlayersCount returns the value of PlayersCount.
+func (s *ServerStatisticPoint) GetPlayersCount() NilInt32 {
+	return s.PlayersCount
+}
+
+// GetPlayersMin returns the value of PlayersMin.
+func (s *ServerStatisticPoint) GetPlayersMin() OptInt32 {
+	return s.PlayersMin
+}
+
+// GetPlayersMax returns the value of PlayersMax.
+func (s *ServerStatisticPoint) GetPlayersMax() OptInt32 {
+	return s.PlayersMax
+}
+
+// GetPlayersP50 returns the value of PlayersP50.
+func (s *ServerStatisticPoint) GetPlayersP50() OptInt32 {
+	return s.PlayersP50
+}
+
+// GetPlayersP95 returns the value of PlayersP95.
+func (s *ServerStatisticPoint) GetPlayersP95() OptInt32 {
+	return s.PlayersP95
+}
+
+// GetSamples returns the value of Samples.
+func (s *ServerStatisticPoint) GetSamples() OptInt64 {
+	return s.Samples
+}
+
+// SetCollectedAt sets the value of CollectedAt.
+func (s *ServerStatisticPoint) SetCollectedAt(val time.Time) {
+	s.CollectedAt = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ServerStatisticPoint) SetStatus(val ServerStatisticPointStatus) {
+	s.Status = val
+}
+
+// SetPlayersCount sets the value of PlayersCount.
+func (s *ServerStatisticPoint) SetPlayersCount(val NilInt32) {
+	s.PlayersCount = val
+}
+
+// SetPlayersMin sets the value of PlayersMin.
+func (s *ServerStatisticPoint) SetPlayersMin(val OptInt32) {
+	s.PlayersMin = val
+}
+
+// SetPlayersMax sets the value of PlayersMax.
+func (s *ServerStatisticPoint) SetPlayersMax(val OptInt32) {
+	s.PlayersMax = val
+}
+
+// SetPlayersP50 sets the value of PlayersP50.
+func (s *ServerStatisticPoint) SetPlayersP50(val OptInt32) {
+	s.PlayersP50 = val
+}
+
+// SetPlayersP95 sets the value of PlayersP95.
+func (s *ServerStatisticPoint) SetPlayersP95(val OptInt32) {
+	s.PlayersP95 = val
+}
+
+// SetSamples sets the value of Samples.
+func (s *ServerStatisticPoint) SetSamples(val OptInt64) {
+	s.Samples = val
+}
+
+// Status of the server in the bucket, offline means that server was missing from successful
+// collections,
+// collectionFailed means that collections of the multiplayer failed and unknown means that there
+// were no collections.
+type ServerStatisticPointStatus string
+
+const (
+	ServerStatisticPointStatusOnline           ServerStatisticPointStatus = "online"
+	ServerStatisticPointStatusOffline          ServerStatisticPointStatus = "offline"
+	ServerStatisticPointStatusCollectionFailed ServerStatisticPointStatus = "collectionFailed"
+	ServerStatisticPointStatusUnknown          ServerStatisticPointStatus = "unknown"
+)
+
+// AllValues returns all ServerStatisticPointStatus values.
+func (ServerStatisticPointStatus) AllValues() []ServerStatisticPointStatus {
+	return []ServerStatisticPointStatus{
+		ServerStatisticPointStatusOnline,
+		ServerStatisticPointStatusOffline,
+		ServerStatisticPointStatusCollectionFailed,
+		ServerStatisticPointStatusUnknown,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ServerStatisticPointStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ServerStatisticPointStatusOnline:
+		return []byte(s), nil
+	case ServerStatisticPointStatusOffline:
+		return []byte(s), nil
+	case ServerStatisticPointStatusCollectionFailed:
+		return []byte(s), nil
+	case ServerStatisticPointStatusUnknown:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ServerStatisticPointStatus) UnmarshalText(data []byte) error {
+	switch ServerStatisticPointStatus(data) {
+	case ServerStatisticPointStatusOnline:
+		*s = ServerStatisticPointStatusOnline
+		return nil
+	case ServerStatisticPointStatusOffline:
+		*s = ServerStatisticPointStatusOffline
+		return nil
+	case ServerStatisticPointStatusCollectionFailed:
+		*s = ServerStatisticPointStatusCollectionFailed
+		return nil
+	case ServerStatisticPointStatusUnknown:
+		*s = ServerStatisticPointStatusUnknown
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ServerSummariesPage
+type ServerSummariesPage struct {
+	Servers []ServerSummary `json:"servers"`
+	// Cursor of the next page, absent on the last page.
+	NextCursor OptString `json:"nextCursor"`
+	// Count of all servers of the multiplayer.
+	Total int64 `json:"total"`
+}
+
+// GetServers returns the value of Servers.
+func (s *ServerSummariesPage) GetServers() []ServerSummary {
+	return s.Servers
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ServerSummariesPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// GetTotal returns the value of Total.
+func (s *ServerSummariesPage) GetTotal() int64 {
+	return s.Total
+}
+
+// SetServers sets the value of Servers.
+func (s *ServerSummariesPage) SetServers(val []ServerSummary) {
+	s.Servers = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ServerSummariesPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ServerSummariesPage) SetTotal(val int64) {
+	s.Total = val
+}
+
+func (*ServerSummariesPage) listServerSummariesPageRes() {}
+
+// Ref: #/components/schemas/ServerSummary
+type ServerSummary struct {
+	Host         string `json:"host"`
+	Name         string `json:"name"`
+	PlayersCount int32  `json:"playersCount"`
+	// Whether server is present in the latest snapshot of the multiplayer.
+	Online bool `json:"online"`
+	// Time of the latest snapshot, that server was present in.
+	LastSeenAt time.Time `json:"lastSeenAt"`
+}
+
+// GetHost returns the value of Host.
+func (s *ServerSummary) GetHost() string {
 	return s.Host
 }
 
@@ -495,6 +1541,16 @@ func (s *ServerSummary) GetPlayersCount() int32 {
 	return s.PlayersCount
 }
 
+// GetOnline returns the value of Online.
+func (s *ServerSummary) GetOnline() bool {
+	return s.Online
+}
+
+// GetLastSeenAt returns the value of LastSeenAt.
+func (s *ServerSummary) GetLastSeenAt() time.Time {
+	return s.LastSeenAt
+}
+
 // SetHost sets the value of Host.
 func (s *ServerSummary) SetHost(val string) {
 	s.Host = val
@@ -509,3 +1565,245 @@ func (s *ServerSummary) SetName(val string) {
 func (s *ServerSummary) SetPlayersCount(val int32) {
 	s.PlayersCount = val
 }
+
+// SetOnline sets the value of Online.
+func (s *ServerSummary) SetOnline(val bool) {
+	s.Online = val
+}
+
+// SetLastSeenAt sets the value of LastSeenAt.
+func (s *ServerSummary) SetLastSeenAt(val time.Time) {
+	s.LastSeenAt = val
+}
+
+// Ref: #/components/schemas/ServerUptime
+type ServerUptime struct {
+	FirstSeenAt time.Time `json:"firstSeenAt"`
+	LastSeenAt  time.Time `json:"lastSeenAt"`
+	// Percentage of successful collection runs of the last 24 hours, in which server was present,
+	// null when there were no runs since server was first seen.
+	Availability24h OptNilFloat64 `json:"availability24h"`
+	// The same as availability24h for the last 7 days.
+	Availability7d OptNilFloat64 `json:"availability7d"`
+	// The same as availability24h for the last 30 days.
+	Availability30d OptNilFloat64 `json:"availability30d"`
+	// Longest outage of the last 30 days, outage that is not over yet lasts until now.
+	LongestOutageSeconds int64 `json:"longestOutageSeconds"`
+	// Start of the longest outage, absent when there were no outages.
+	LongestOutageStartedAt OptDateTime `json:"longestOutageStartedAt"`
+}
+
+// GetFirstSeenAt returns the value of FirstSeenAt.
+func (s *ServerUptime) GetFirstSeenAt() time.Time {
+	return s.FirstSeenAt
+}
+
+// GetLastSeenAt returns the value of LastSeenAt.
+func (s *ServerUptime) GetLastSeenAt() time.Time {
+	return s.LastSeenAt
+}
+
+// GetAvailability24h returns the value of Availability24h.
+func (s *ServerUptime) GetAvailability24h() OptNilFloat64 {
+	return s.Availability24h
+}
+
+// GetAvailability7d returns the value of Availability7d.
+func (s *ServerUptime) GetAvailability7d() OptNilFloat64 {
+	return s.Availability7d
+}
+
+// GetAvailability30d returns the value of Availability30d.
+func (s *ServerUptime) GetAvailability30d() OptNilFloat64 {
+	return s.Availability30d
+}
+
+// GetLongestOutageSeconds returns the value of LongestOutageSeconds.
+func (s *ServerUptime) GetLongestOutageSeconds() int64 {
+	return s.LongestOutageSeconds
+}
+
+// GetLongestOutageStartedAt returns the value of LongestOutageStartedAt.
+func (s *ServerUptime) GetLongestOutageStartedAt() OptDateTime {
+	return s.LongestOutageStartedAt
+}
+
+// SetFirstSeenAt sets the value of FirstSeenAt.
+func (s *ServerUptime) SetFirstSeenAt(val time.Time) {
+	s.FirstSeenAt = val
+}
+
+// SetLastSeenAt sets the value of LastSeenAt.
+func (s *ServerUptime) SetLastSeenAt(val time.Time) {
+	s.LastSeenAt = val
+}
+
+// SetAvailability24h sets the value of Availability24h.
+func (s *ServerUptime) SetAvailability24h(val OptNilFloat64) {
+	s.Availability24h = val
+}
+
+// SetAvailability7d sets the value of Availability7d.
+func (s *ServerUptime) SetAvailability7d(val OptNilFloat64) {
+	s.Availability7d = val
+}
+
+// SetAvailability30d sets the value of Availability30d.
+func (s *ServerUptime) SetAvailability30d(val OptNilFloat64) {
+	s.Availability30d = val
+}
+
+// SetLongestOutageSeconds sets the value of LongestOutageSeconds.
+func (s *ServerUptime) SetLongestOutageSeconds(val int64) {
+	s.LongestOutageSeconds = val
+}
+
+// SetLongestOutageStartedAt sets the value of LongestOutageStartedAt.
+func (s *ServerUptime) SetLongestOutageStartedAt(val OptDateTime) {
+	s.LongestOutageStartedAt = val
+}
+
+func (*ServerUptime) getServerUptimeRes() {}
+
+// Ref: #/components/schemas/StatisticsPrecision
+type StatisticsPrecision string
+
+const (
+	StatisticsPrecisionPer5Minutes  StatisticsPrecision = "per5Minutes"
+	StatisticsPrecisionPer15Minutes StatisticsPrecision = "per15Minutes"
+	StatisticsPrecisionPerHour      StatisticsPrecision = "perHour"
+	StatisticsPrecisionPerDay       StatisticsPrecision = "perDay"
+	StatisticsPrecisionPerWeek      StatisticsPrecision = "perWeek"
+	StatisticsPrecisionPerMonth     StatisticsPrecision = "perMonth"
+)
+
+// AllValues returns all StatisticsPrecision values.
+func (StatisticsPrecision) AllValues() []StatisticsPrecision {
+	return []StatisticsPrecision{
+		StatisticsPrecisionPer5Minutes,
+		StatisticsPrecisionPer15Minutes,
+		StatisticsPrecisionPerHour,
+		StatisticsPrecisionPerDay,
+		StatisticsPrecisionPerWeek,
+		StatisticsPrecisionPerMonth,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s StatisticsPrecision) MarshalText() ([]byte, error) {
+	switch s {
+	case StatisticsPrecisionPer5Minutes:
+		return []byte(s), nil
+	case StatisticsPrecisionPer15Minutes:
+		return []byte(s), nil
+	case StatisticsPrecisionPerHour:
+		return []byte(s), nil
+	case StatisticsPrecisionPerDay:
+		return []byte(s), nil
+	case StatisticsPrecisionPerWeek:
+		return []byte(s), nil
+	case StatisticsPrecisionPerMonth:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StatisticsPrecision) UnmarshalText(data []byte) error {
+	switch StatisticsPrecision(data) {
+	case StatisticsPrecisionPer5Minutes:
+		*s = StatisticsPrecisionPer5Minutes
+		return nil
+	case StatisticsPrecisionPer15Minutes:
+		*s = StatisticsPrecisionPer15Minutes
+		return nil
+	case StatisticsPrecisionPerHour:
+		*s = StatisticsPrecisionPerHour
+		return nil
+	case StatisticsPrecisionPerDay:
+		*s = StatisticsPrecisionPerDay
+		return nil
+	case StatisticsPrecisionPerWeek:
+		*s = StatisticsPrecisionPerWeek
+		return nil
+	case StatisticsPrecisionPerMonth:
+		*s = StatisticsPrecisionPerMonth
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TrendingServer
+type TrendingServer struct {
+	Host string `json:"host"`
+	Name string `json:"name"`
+	// Average players count in the previous window.
+	PlayersBefore float64 `json:"playersBefore"`
+	// Average players count in the window.
+	PlayersAfter  float64 `json:"playersAfter"`
+	PlayersChange float64 `json:"playersChange"`
+	// Change relative to the previous window, absent when server had no players in it.
+	RelativeChange OptFloat64 `json:"relativeChange"`
+}
+
+// GetHost returns the value of Host.
+func (s *TrendingServer) GetHost() string {
+	return s.Host
+}
+
+// GetName returns the value of Name.
+func (s *TrendingServer) GetName() string {
+	return s.Name
+}
+
+// GetPlayersBefore returns the value of PlayersBefore.
+func (s *TrendingServer) GetPlayersBefore() float64 {
+	return s.PlayersBefore
+}
+
+// GetPlayersAfter returns the value of PlayersAfter.
+func (s *TrendingServer) GetPlayersAfter() float64 {
+	return s.PlayersAfter
+}
+
+// GetPlayersChange returns the value of PlayersChange.
+func (s *TrendingServer) GetPlayersChange() float64 {
+	return s.PlayersChange
+}
+
+// GetRelativeChange returns the value of RelativeChange.
+func (s *TrendingServer) GetRelativeChange() OptFloat64 {
+	return s.RelativeChange
+}
+
+// SetHost sets the value of Host.
+func (s *TrendingServer) SetHost(val string) {
+	s.Host = val
+}
+
+// SetName sets the value of Name.
+func (s *TrendingServer) SetName(val string) {
+	s.Name = val
+}
+
+// SetPlayersBefore sets the value of PlayersBefore.
+func (s *TrendingServer) SetPlayersBefore(val float64) {
+	s.PlayersBefore = val
+}
+
+// SetPlayersAfter sets the value of PlayersAfter.
+func (s *TrendingServer) SetPlayersAfter(val float64) {
+	s.PlayersAfter = val
+}
+
+// SetPlayersChange sets the value of PlayersChange.
+func (s *TrendingServer) SetPlayersChange(val float64) {
+	s.PlayersChange = val
+}
+
+// SetRelativeChange sets the value of RelativeChange.
+func (s *TrendingServer) SetRelativeChange(val OptFloat64) {
+	s.RelativeChange = val
+}
@@ -14,12 +14,30 @@ type Handler interface {
 	//
 	// GET /multiplayer/{multiplayerName}/server/{serverHost}
 	GetServer(ctx context.Context, params GetServerParams) (GetServerRes, error)
+	// GetServerUptime implements getServerUptime operation.
+	//
+	// Get server availability by successful collection runs.
+	//
+	// GET /multiplayer/{multiplayerName}/server/{serverHost}/uptime
+	GetServerUptime(ctx context.Context, params GetServerUptimeParams) (GetServerUptimeRes, error)
+	// ListMultiplayerStatistics implements listMultiplayerStatistics operation.
+	//
+	// Total players and online servers count of the multiplayer, averaged over snapshots in the bucket.
+	//
+	// GET /multiplayer/{multiplayerName}/statistics
+	ListMultiplayerStatistics(ctx context.Context, params ListMultiplayerStatisticsParams) (ListMultiplayerStatisticsRes, error)
 	// ListMultiplayerSummaries implements listMultiplayerSummaries operation.
 	//
 	// Get a summary of multiplayer platforms.
 	//
 	// GET /multiplayers/summaries
 	ListMultiplayerSummaries(ctx context.Context, params ListMultiplayerSummariesParams) ([]MultiplayerSummary, error)
+	// ListServerHistory implements listServerHistory operation.
+	//
+	// List server metadata changes, the newest first.
+	//
+	// GET /multiplayer/{multiplayerName}/server/{serverHost}/history
+	ListServerHistory(ctx context.Context, params ListServerHistoryParams) (ListServerHistoryRes, error)
 	// ListServerStatistics implements listServerStatistics operation.
 	//
 	// Get server statistics by host.
@@ -32,6 +50,27 @@ type Handler interface {
 	//
 	// GET /multiplayer/{multiplayerName}/servers
 	ListServerSummaries(ctx context.Context, params ListServerSummariesParams) (ListServerSummariesRes, error)
+	// ListServerSummariesPage implements listServerSummariesPage operation.
+	//
+	// Version 2 of listServerSummaries, that returns servers ordered by players count and host in an
+	// envelope.
+	// Pages are keyset paginated, so they do not shift between collections.
+	//
+	// GET /v2/multiplayer/{multiplayerName}/servers
+	ListServerSummariesPage(ctx context.Context, params ListServerSummariesPageParams) (ListServerSummariesPageRes, error)
+	// ListTrendingServers implements listTrendingServers operation.
+	//
+	// Servers ranked by change of average players in the window compared with the previous window of the
+	// same length.
+	//
+	// GET /multiplayer/{multiplayerName}/trending
+	ListTrendingServers(ctx context.Context, params ListTrendingServersParams) (ListTrendingServersRes, error)
+	// SearchServers implements searchServers operation.
+	//
+	// Servers are ordered by current players count, facets are counted by all found servers.
+	//
+	// GET /multiplayer/{multiplayerName}/search
+	SearchServers(ctx context.Context, params SearchServersParams) (SearchServersRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -22,6 +22,24 @@ func (UnimplementedHandler) GetServer(ctx context.Context, params GetServerParam
 	return r, ht.ErrNotImplemented
 }
 
+// GetServerUptime implements getServerUptime operation.
+//
+// Get server availability by successful collection runs.
+//
+// GET /multiplayer/{multiplayerName}/server/{serverHost}/uptime
+func (UnimplementedHandler) GetServerUptime(ctx context.Context, params GetServerUptimeParams) (r GetServerUptimeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMultiplayerStatistics implements listMultiplayerStatistics operation.
+//
+// Total players and online servers count of the multiplayer, averaged over snapshots in the bucket.
+//
+// GET /multiplayer/{multiplayerName}/statistics
+func (UnimplementedHandler) ListMultiplayerStatistics(ctx context.Context, params ListMultiplayerStatisticsParams) (r ListMultiplayerStatisticsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListMultiplayerSummaries implements listMultiplayerSummaries operation.
 //
 // Get a summary of multiplayer platforms.
@@ -31,6 +49,15 @@ func (UnimplementedHandler) ListMultiplayerSummaries(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// ListServerHistory implements listServerHistory operation.
+//
+// List server metadata changes, the newest first.
+//
+// GET /multiplayer/{multiplayerName}/server/{serverHost}/history
+func (UnimplementedHandler) ListServerHistory(ctx context.Context, params ListServerHistoryParams) (r ListServerHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListServerStatistics implements listServerStatistics operation.
 //
 // Get server statistics by host.
@@ -48,3 +75,33 @@ func (UnimplementedHandler) ListServerStatistics(ctx context.Context, params Lis
 func (UnimplementedHandler) ListServerSummaries(ctx context.Context, params ListServerSummariesParams) (r ListServerSummariesRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// ListServerSummariesPage implements listServerSummariesPage operation.
+//
+// Version 2 of listServerSummaries, that returns servers ordered by players count and host in an
+// envelope.
+// Pages are keyset paginated, so they do not shift between collections.
+//
+// GET /v2/multiplayer/{multiplayerName}/servers
+func (UnimplementedHandler) ListServerSummariesPage(ctx context.Context, params ListServerSummariesPageParams) (r ListServerSummariesPageRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTrendingServers implements listTrendingServers operation.
+//
+// Servers ranked by change of average players in the window compared with the previous window of the
+// same length.
+//
+// GET /multiplayer/{multiplayerName}/trending
+func (UnimplementedHandler) ListTrendingServers(ctx context.Context, params ListTrendingServersParams) (r ListTrendingServersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchServers implements searchServers operation.
+//
+// Servers are ordered by current players count, facets are counted by all found servers.
+//
+// GET /multiplayer/{multiplayerName}/search
+func (UnimplementedHandler) SearchServers(ctx context.Context, params SearchServersParams) (r SearchServersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}